@@ -0,0 +1,225 @@
+package embgui
+
+import (
+	"strconv"
+	"strings"
+)
+
+// BarSeries is a named list of values, one value per category
+// see BarChart()
+type BarSeries struct {
+	Name   string
+	Values []float64
+}
+
+// BarOptions customizes BarChart
+// by default bars are vertical and grouped side by side
+type BarOptions struct {
+	Horizontal  bool
+	Stacked     bool
+	ValueLabels bool
+}
+
+// BarChart generates server-side rendered SVG bar chart
+// every series has a value for each category, missing, NaN and infinite values are treated as 0
+// negative values are drawn below (or left of) the zero line
+// a data table is attached for text-based browsers
+//
+//		page.BarChart("errors", []string{"/login", "/users"}, embgui.BarOptions{Stacked: true},
+//		embgui.BarSeries{Name: "4xx", Values: []float64{12, 3}},
+//		embgui.BarSeries{Name: "5xx", Values: []float64{1, 0}})
+func (n *EmbNode) BarChart(title string, categories []string, opts BarOptions, series ...BarSeries) *EmbNode {
+	return n.barChart(title, "category", categories, opts, series)
+}
+
+// BarChartFromTable generates bar chart from GenTableBody-style header and rows
+// the first column holds categories, every other column is a series named after its header
+// cells that are not numbers are treated as 0
+//
+//		page.BarChartFromTable("queues", []string{"queue", "depth"}, [][]string{{"mail", "12"}, {"sms", "3"}}, embgui.BarOptions{})
+func (n *EmbNode) BarChartFromTable(title string, header []string, rows [][]string, opts BarOptions) *EmbNode {
+	label := "category"
+	if len(header) > 0 {
+		label = header[0]
+	}
+	var series []BarSeries
+	for i := 1; i < len(header); i++ {
+		series = append(series, BarSeries{Name: header[i], Values: make([]float64, len(rows))})
+	}
+	categories := make([]string, len(rows))
+	for r, row := range rows {
+		for c, cell := range row {
+			if c == 0 {
+				categories[r] = cell
+				continue
+			}
+			if c > len(series) {
+				break
+			}
+			v, err := strconv.ParseFloat(strings.TrimSpace(cell), 64)
+			if err == nil {
+				series[c-1].Values[r] = v
+			}
+		}
+	}
+	return n.barChart(title, label, categories, opts, series)
+}
+
+// barValue returns value of a series for a given category
+// missing, NaN and infinite values are returned as 0
+func barValue(sr BarSeries, i int) float64 {
+	if i < len(sr.Values) && finite(sr.Values[i]) {
+		return sr.Values[i]
+	}
+	return 0
+}
+
+// barChart renders bar chart, label is a header of categories column in a text fallback
+func (n *EmbNode) barChart(title string, label string, categories []string, opts BarOptions, series []BarSeries) *EmbNode {
+	vMin, vMax := 0.0, 0.0
+	legend := false
+	for _, sr := range series {
+		if sr.Name != "" {
+			legend = true
+		}
+	}
+	for i := range categories {
+		pos, neg := 0.0, 0.0
+		for _, sr := range series {
+			v := barValue(sr, i)
+			if opts.Stacked {
+				if v > 0 {
+					pos += v
+				} else {
+					neg += v
+				}
+				continue
+			}
+			vMin, vMax = minFloat(vMin, v), maxFloat(vMax, v)
+		}
+		vMin, vMax = minFloat(vMin, neg), maxFloat(vMax, pos)
+	}
+	ticks := niceTicks(vMin, vMax, 5)
+	labels := tickLabels(ticks)
+
+	var p *xyPlot
+	if opts.Horizontal {
+		p = newXYPlot(title, legend, categories)
+		p.xMin, p.xMax = ticks[0], ticks[len(ticks)-1]
+	} else {
+		p = newXYPlot(title, legend, labels)
+		p.yMin, p.yMax = ticks[0], ticks[len(ticks)-1]
+	}
+
+	s := newSVG(chartWidth, chartHeight, title)
+	drawTitle(s, title)
+	band := p.right - p.left
+	if opts.Horizontal {
+		band = p.bottom - p.top
+	}
+	if len(categories) > 0 {
+		band /= float64(len(categories))
+	}
+	if opts.Horizontal {
+		p.drawXAxis(s, ticks, labels, true)
+		s.line(p.x(0), p.top, p.x(0), p.bottom, axisColor)
+		for i, c := range categories {
+			s.text(p.left-6, p.top+band*(float64(i)+0.5)+4, "end", axisColor, c)
+		}
+	} else {
+		p.drawYAxis(s, ticks, labels)
+		s.line(p.left, p.y(0), p.right, p.y(0), axisColor)
+		for i, c := range categories {
+			s.text(p.left+band*(float64(i)+0.5), p.bottom+16, "middle", axisColor, c)
+		}
+	}
+
+	// bar draws a bar from one value to another inside category's band
+	bar := func(i int, offset, width, from, to float64, color string, tooltip string) {
+		start := band*float64(i) + offset
+		if opts.Horizontal {
+			x1, x2 := p.x(minFloat(from, to)), p.x(maxFloat(from, to))
+			s.rect(x1, p.top+start, x2-x1, width, color, tooltip)
+			return
+		}
+		y1, y2 := p.y(maxFloat(from, to)), p.y(minFloat(from, to))
+		s.rect(p.left+start, y1, width, y2-y1, color, tooltip)
+	}
+	// valueLabel draws value next to the end of a bar, or in the middle of a stacked segment
+	valueLabel := func(i int, center, from, to float64) {
+		pos := band*float64(i) + center
+		v := to - from
+		if opts.Horizontal {
+			switch {
+			case opts.Stacked:
+				s.text(p.x((from+to)/2), p.top+pos+4, "middle", axisColor, fmtValue(v))
+			case v < 0:
+				s.text(p.x(to)-4, p.top+pos+4, "end", axisColor, fmtValue(v))
+			default:
+				s.text(p.x(to)+4, p.top+pos+4, "start", axisColor, fmtValue(v))
+			}
+			return
+		}
+		switch {
+		case opts.Stacked:
+			s.text(p.left+pos, p.y((from+to)/2)+4, "middle", axisColor, fmtValue(v))
+		case v < 0:
+			s.text(p.left+pos, p.y(to)+12, "middle", axisColor, fmtValue(v))
+		default:
+			s.text(p.left+pos, p.y(to)-4, "middle", axisColor, fmtValue(v))
+		}
+	}
+
+	group := band * 0.8
+	width := group
+	if !opts.Stacked && len(series) > 0 {
+		width = group / float64(len(series))
+	}
+	for i, c := range categories {
+		pos, neg := 0.0, 0.0
+		for j, sr := range series {
+			v := barValue(sr, i)
+			tooltip := c + ": " + fmtValue(v)
+			if sr.Name != "" {
+				tooltip = sr.Name + " / " + tooltip
+			}
+			from := 0.0
+			offset := band * 0.1
+			if opts.Stacked {
+				if v >= 0 {
+					from, pos = pos, pos+v
+				} else {
+					from, neg = neg, neg+v
+				}
+			} else {
+				offset += width * float64(j)
+			}
+			bar(i, offset, width, from, from+v, seriesColor(j), tooltip)
+			if opts.ValueLabels && v != 0 {
+				valueLabel(i, offset+width/2, from, from+v)
+			}
+		}
+	}
+	if legend {
+		var names, colors []string
+		for j, sr := range series {
+			names, colors = append(names, sr.Name), append(colors, seriesColor(j))
+		}
+		drawLegend(s, names, colors)
+	}
+
+	figure := n.chart(s.String())
+	header := []string{label}
+	for _, sr := range series {
+		header = append(header, sr.Name)
+	}
+	table := figure.textFallback().GenTableBody(header)
+	for i, c := range categories {
+		row := table.Tr()
+		row.Td(c)
+		for _, sr := range series {
+			row.Td(fmtValue(barValue(sr, i)))
+		}
+	}
+	return figure
+}
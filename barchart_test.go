@@ -0,0 +1,58 @@
+package embgui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBarChart(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	series := []BarSeries{
+		{Name: "4xx", Values: []float64{12, 3}},
+		{Name: "5xx", Values: []float64{-2, 1}},
+	}
+	for _, opts := range []BarOptions{{}, {Stacked: true}, {Horizontal: true, ValueLabels: true}} {
+		v := page.BarChart("errors", []string{"/login", "/users"}, opts, series...).render()
+		testStrings := []string{`<figure class='embgui-chart'><svg`,
+			`<title>4xx / /login: 12</title>`,
+			`<title>5xx / /login: -2</title>`,
+			`fill='#ff3860'`,
+			`<tr><td>/login</td><td>12</td><td>-2</td></tr>`}
+		if opts.ValueLabels {
+			testStrings = append(testStrings, `text-anchor='end' fill='#4a4a4a'>-2</text>`)
+		}
+		for _, str := range testStrings {
+			if strings.Contains(v, str) == false {
+				t.Error(
+					"For", "TestBarChart", opts,
+					"expected to have", str,
+					"got", v,
+				)
+			}
+		}
+	}
+}
+
+func TestBarChartFromTable(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	v := page.BarChartFromTable("queues", []string{"queue", "depth"},
+		[][]string{{"mail", "12"}, {"sms", "n/a"}}, BarOptions{}).render()
+	testStrings := []string{`<th>queue</th><th>depth</th>`,
+		`<tr><td>mail</td><td>12</td></tr>`,
+		`<tr><td>sms</td><td>0</td></tr>`}
+	for _, str := range testStrings {
+		if strings.Contains(v, str) == false {
+			t.Error(
+				"For", "TestBarChartFromTable",
+				"expected to have", str,
+				"got", v,
+			)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package embgui
+
+// cardGridClass marks a container created by CardGrid()
+const cardGridClass = "columns is-multiline"
+
+// CardGrid generates responsive grid for cards
+// cards added to the grid are wrapped into columns: three per row on desktop, two on tablet, one on mobile
+//
+//		grid := page.CardGrid()
+//		content, footer := grid.Card("worker-1", "⚙", "")
+func (n *EmbNode) CardGrid() *EmbNode {
+	return n.add(&EmbNode{HTMLTag: "div", Class: cardGridClass})
+}
+
+// Card generates bulma's card with a header, optional image, content and footer
+// icon is an optional text (like an emoji) shown on the right side of the header
+// image is an optional URL of an image shown below the header
+// it returns card's content and footer, see CardFooterLink(), CardFooterAction() and CardFooterDel()
+//
+//		content, footer := page.Card("worker-1", "", "")
+//		content.P("running")
+//		footer.CardFooterLink("Details", "/workers/1")
+//		footer.CardFooterDel("Remove", "/workers/1")
+func (n *EmbNode) Card(title string, icon string, image string) (*EmbNode, *EmbNode) {
+	parent := n
+	if n.Class == cardGridClass {
+		parent = n.add(&EmbNode{HTMLTag: "div", Class: "column is-one-third-desktop is-half-tablet"})
+	}
+	card := parent.add(&EmbNode{HTMLTag: "div", Class: "card"})
+	if title != "" || icon != "" {
+		header := card.add(&EmbNode{HTMLTag: "header", Class: "card-header"})
+		header.add(&EmbNode{HTMLTag: "p", Class: "card-header-title", Text: title})
+		if icon != "" {
+			header.add(&EmbNode{HTMLTag: "span", Class: "card-header-icon", Text: icon})
+		}
+	}
+	if image != "" {
+		card.add(&EmbNode{HTMLTag: "div", Class: "card-image"}).
+			add(&EmbNode{HTMLTag: "figure", Class: "image"}).
+			add(&EmbNode{HTMLTag: "img", Src: image, Alt: title})
+	}
+	content := card.add(&EmbNode{HTMLTag: "div", Class: "card-content"})
+	footer := card.add(&EmbNode{HTMLTag: "footer", Class: "card-footer"})
+	return content, footer
+}
+
+// CardFooterLink generates a link inside card's footer
+func (n *EmbNode) CardFooterLink(text string, href string) *EmbNode {
+	return n.add(&EmbNode{Text: text, HTMLTag: "a", Href: href, Class: "card-footer-item"})
+}
+
+// CardFooterAction generates POST button inside card's footer, see ActionButton()
+func (n *EmbNode) CardFooterAction(text string, action string) *EmbNode {
+	return n.add(&EmbNode{HTMLTag: "div", Class: "card-footer-item"}).MiniActionButton(text, action)
+}
+
+// CardFooterDel generates DEL button inside card's footer, see DelButton()
+// your framework should support hidden _method tag
+func (n *EmbNode) CardFooterDel(text string, action string) *EmbNode {
+	return n.add(&EmbNode{HTMLTag: "div", Class: "card-footer-item"}).MiniDelButton(text, action)
+}
@@ -0,0 +1,33 @@
+package embgui
+
+import (
+	"testing"
+)
+
+func TestCard(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	grid := page.CardGrid()
+	content, footer := grid.Card("worker-1", "⚙", "/img/worker.png")
+	content.P("running")
+	footer.CardFooterLink("Details", "/workers/1")
+	footer.CardFooterDel("Remove", "/workers/1")
+	v := grid.render()
+	expectedResult := `<div class='columns is-multiline'><div class='column is-one-third-desktop is-half-tablet'><div class='card'>` +
+		`<header class='card-header'><p class='card-header-title'>worker-1</p><span class='card-header-icon'>⚙</span></header>` +
+		`<div class='card-image'><figure class='image'><img src='/img/worker.png' alt='worker-1'></img></figure></div>` +
+		`<div class='card-content'><p>running</p></div><footer class='card-footer'>` +
+		`<a class='card-footer-item' href='/workers/1'>Details</a><div class='card-footer-item'>` +
+		`<form action='/workers/1' method='POST'><input type='hidden' name='_method' value='DELETE'></input>` +
+		`<button class='button is-danger is-small' type='submit' style='margin: .25rem'>Remove</button></form></div>` +
+		`</footer></div></div></div>`
+	if v != expectedResult {
+		t.Error(
+			"For", "TestCard",
+			"expected", expectedResult,
+			"got", v,
+		)
+	}
+}
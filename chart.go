@@ -0,0 +1,244 @@
+package embgui
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	// chartWidth is a width of SVG charts view box, charts are scaled to container's width
+	chartWidth = 640
+	// chartHeight is a height of SVG charts view box
+	chartHeight = 320
+)
+
+// Point is a single (x, y) value on a chart
+type Point struct {
+	X float64
+	Y float64
+}
+
+// Series is a named list of points, drawn as a single line
+// see LineChart()
+type Series struct {
+	Name   string
+	Points []Point
+}
+
+// xyPlot maps values onto a rectangular plot area of a chart
+type xyPlot struct {
+	left   float64
+	top    float64
+	right  float64
+	bottom float64
+	xMin   float64
+	xMax   float64
+	yMin   float64
+	yMax   float64
+}
+
+// newXYPlot computes plot area that leaves space for title, legend and y labels
+func newXYPlot(title string, legend bool, yLabels []string) *xyPlot {
+	longest := 0
+	for _, l := range yLabels {
+		if len(l) > longest {
+			longest = len(l)
+		}
+	}
+	p := &xyPlot{left: 14 + 6.5*float64(longest), top: 15, right: chartWidth - 20, bottom: chartHeight - 25}
+	if title != "" {
+		p.top += 20
+	}
+	if legend {
+		p.bottom -= 20
+	}
+	return p
+}
+
+// x returns horizontal position of a value
+func (p *xyPlot) x(v float64) float64 {
+	if p.xMax == p.xMin {
+		return p.left
+	}
+	return p.left + (v-p.xMin)/(p.xMax-p.xMin)*(p.right-p.left)
+}
+
+// y returns vertical position of a value
+func (p *xyPlot) y(v float64) float64 {
+	if p.yMax == p.yMin {
+		return p.bottom
+	}
+	return p.bottom - (v-p.yMin)/(p.yMax-p.yMin)*(p.bottom-p.top)
+}
+
+// drawYAxis draws vertical axis with labels and horizontal grid lines
+func (p *xyPlot) drawYAxis(s *svgCanvas, ticks []float64, labels []string) {
+	for i, t := range ticks {
+		y := p.y(t)
+		s.line(p.left, y, p.right, y, gridColor)
+		s.text(p.left-6, y+4, "end", axisColor, labels[i])
+	}
+	s.line(p.left, p.top, p.left, p.bottom, axisColor)
+}
+
+// drawXAxis draws horizontal axis with tick marks and labels
+// if grid is true, vertical grid lines are drawn as well
+func (p *xyPlot) drawXAxis(s *svgCanvas, ticks []float64, labels []string, grid bool) {
+	for i, t := range ticks {
+		x := p.x(t)
+		if grid {
+			s.line(x, p.top, x, p.bottom, gridColor)
+		}
+		s.line(x, p.bottom, x, p.bottom+4, axisColor)
+		s.text(x, p.bottom+16, "middle", axisColor, labels[i])
+	}
+	s.line(p.left, p.bottom, p.right, p.bottom, axisColor)
+}
+
+// drawTitle draws chart's title on top of the chart
+func drawTitle(s *svgCanvas, title string) {
+	if title == "" {
+		return
+	}
+	s.tag("text", title, "x", fmtFloat(chartWidth/2), "y", "20", "text-anchor", "middle",
+		"font-size", "14", "font-weight", "bold", "fill", axisColor)
+}
+
+// drawLegend draws a row of color boxes with series names at the bottom of the chart
+func drawLegend(s *svgCanvas, names []string, colors []string) {
+	x := 20.0
+	y := float64(chartHeight - 18)
+	for i, name := range names {
+		s.rect(x, y, 10, 10, colors[i], "")
+		s.text(x+14, y+9, "start", axisColor, name)
+		x += 30 + 6.5*float64(len(name))
+	}
+}
+
+// tickLabels formats tick values using precision required by the ticks
+func tickLabels(ticks []float64) []string {
+	step := 1.0
+	if len(ticks) > 1 {
+		step = ticks[1] - ticks[0]
+	}
+	labels := make([]string, len(ticks))
+	for i, t := range ticks {
+		labels[i] = fmtTick(t, step)
+	}
+	return labels
+}
+
+// fmtValue formats a data value for labels and text fallbacks
+func fmtValue(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// chart adds a figure with inline SVG image
+func (n *EmbNode) chart(svg string) *EmbNode {
+	return n.add(&EmbNode{HTMLTag: "figure", Class: "embgui-chart", Text: svg, Unsafe: true})
+}
+
+// textFallback adds a block hidden with CSS
+// text-based browsers ignore CSS and show it instead of SVG image
+func (n *EmbNode) textFallback() *EmbNode {
+	return n.add(&EmbNode{HTMLTag: "div", Class: "is-hidden"})
+}
+
+// LineChart generates server-side rendered SVG line chart with axes and a legend
+// title is optional, each series gets its own color from bulma's palette
+// points with NaN or infinite coordinates are not drawn, the line is broken at them
+// a data table is attached for text-based browsers
+//
+//		page.LineChart("requests", embgui.Series{Name: "GET", Points: []embgui.Point{{X: 1, Y: 10}, {X: 2, Y: 12}}},
+//		embgui.Series{Name: "POST", Points: []embgui.Point{{X: 1, Y: 3}, {X: 2, Y: 7}}})
+func (n *EmbNode) LineChart(title string, series ...Series) *EmbNode {
+	xMin, xMax, yMin, yMax := 0.0, 1.0, 0.0, 1.0
+	first := true
+	legend := false
+	for _, sr := range series {
+		if sr.Name != "" {
+			legend = true
+		}
+		for _, pt := range sr.Points {
+			if !finite(pt.X) || !finite(pt.Y) {
+				continue
+			}
+			if first {
+				xMin, xMax, yMin, yMax = pt.X, pt.X, pt.Y, pt.Y
+				first = false
+			}
+			xMin, xMax = minFloat(xMin, pt.X), maxFloat(xMax, pt.X)
+			yMin, yMax = minFloat(yMin, pt.Y), maxFloat(yMax, pt.Y)
+		}
+	}
+	xTicks := niceTicks(xMin, xMax, 8)
+	yTicks := niceTicks(yMin, yMax, 5)
+	yLabels := tickLabels(yTicks)
+	p := newXYPlot(title, legend, yLabels)
+	p.xMin, p.xMax = xTicks[0], xTicks[len(xTicks)-1]
+	p.yMin, p.yMax = yTicks[0], yTicks[len(yTicks)-1]
+
+	s := newSVG(chartWidth, chartHeight, title)
+	drawTitle(s, title)
+	p.drawYAxis(s, yTicks, yLabels)
+	p.drawXAxis(s, xTicks, tickLabels(xTicks), false)
+	var names, colors []string
+	for i, sr := range series {
+		color := seriesColor(i)
+		names, colors = append(names, sr.Name), append(colors, color)
+		var segment []string
+		flush := func() {
+			if len(segment) > 1 {
+				s.tag("polyline", "", "points", strings.Join(segment, " "), "fill", "none", "stroke", color, "stroke-width", "2")
+			}
+			segment = nil
+		}
+		for _, pt := range sr.Points {
+			if !finite(pt.X) || !finite(pt.Y) {
+				flush()
+				continue
+			}
+			segment = append(segment, fmtFloat(p.x(pt.X))+","+fmtFloat(p.y(pt.Y)))
+		}
+		flush()
+		for _, pt := range sr.Points {
+			if !finite(pt.X) || !finite(pt.Y) {
+				continue
+			}
+			s.open("circle", "cx", fmtFloat(p.x(pt.X)), "cy", fmtFloat(p.y(pt.Y)), "r", "2.5", "fill", color)
+			s.tag("title", sr.Name+" ("+fmtValue(pt.X)+", "+fmtValue(pt.Y)+")")
+			s.end("circle")
+		}
+	}
+	if legend {
+		drawLegend(s, names, colors)
+	}
+
+	figure := n.chart(s.String())
+	table := figure.textFallback().GenTableBody([]string{"series", "x", "y"})
+	for _, sr := range series {
+		for _, pt := range sr.Points {
+			row := table.Tr()
+			row.Td(sr.Name)
+			row.Td(fmtValue(pt.X))
+			row.Td(fmtValue(pt.Y))
+		}
+	}
+	return figure
+}
+
+// minFloat returns the smaller of two numbers
+func minFloat(a, b float64) float64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// maxFloat returns the bigger of two numbers
+func maxFloat(a, b float64) float64 {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,107 @@
+package embgui
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNiceTicks(t *testing.T) {
+	tests := []struct {
+		min      float64
+		max      float64
+		count    int
+		expected []float64
+	}{
+		{0, 10, 5, []float64{0, 2, 4, 6, 8, 10}},
+		{0, 97, 5, []float64{0, 20, 40, 60, 80, 100}},
+		{-3, 12, 5, []float64{-5, 0, 5, 10, 15}},
+		{0.1, 0.35, 5, []float64{0.1, 0.2, 0.3, 0.4}},
+		{5, 5, 5, []float64{4, 4.5, 5, 5.5, 6}},
+		{math.NaN(), math.NaN(), 5, []float64{0, 0.2, 0.4, 0.6, 0.8, 1}},
+		{0, math.Inf(1), 2, []float64{0, 1}},
+		{-math.MaxFloat64, math.MaxFloat64, 5, []float64{-math.MaxFloat64, math.MaxFloat64}},
+	}
+	for _, test := range tests {
+		v := niceTicks(test.min, test.max, test.count)
+		if !reflect.DeepEqual(v, test.expected) {
+			t.Error(
+				"For", "TestNiceTicks", test.min, test.max,
+				"expected", test.expected,
+				"got", v,
+			)
+		}
+	}
+}
+
+func TestLineChart(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	series := []Series{
+		{Name: "GET", Points: []Point{{X: 0, Y: 10}, {X: 1, Y: 12}, {X: 2, Y: 8}}},
+		{Name: "POST", Points: []Point{{X: 0, Y: 3}, {X: 1, Y: 7}, {X: 2, Y: 5}}},
+	}
+	v := page.LineChart("requests", series...).render()
+	if v != page.LineChart("requests", series...).render() {
+		t.Error("For", "TestLineChart", "expected deterministic output")
+	}
+	testStrings := []string{`<figure class='embgui-chart'><svg xmlns='http://www.w3.org/2000/svg' viewBox='0 0 640 320'`,
+		`<title>requests</title>`,
+		`<polyline points='`,
+		`stroke='#3273dc'`,
+		`stroke='#ff3860'`,
+		`>POST</text>`,
+		`<div class='is-hidden'><table class='table is-narrow is-hoverable is-fullwidth'>`,
+		`<tr><td>POST</td><td>1</td><td>7</td></tr>`}
+	for _, str := range testStrings {
+		if strings.Contains(v, str) == false {
+			t.Error(
+				"For", "TestLineChart",
+				"expected to have", str,
+				"got", v,
+			)
+		}
+	}
+}
+
+func TestChartsNonFinite(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	nan, inf := math.NaN(), math.Inf(1)
+	tests := []struct {
+		name  string
+		chart func() *EmbNode
+	}{
+		{"LineChart", func() *EmbNode {
+			return page.LineChart("x", Series{Points: []Point{{1, nan}}})
+		}},
+		{"LineChartGap", func() *EmbNode {
+			return page.LineChart("x", Series{Points: []Point{{0, 1}, {1, 2}, {2, inf}, {3, 1}, {4, 2}}})
+		}},
+		{"BarChart", func() *EmbNode {
+			return page.BarChart("x", []string{"a", "b"}, BarOptions{}, BarSeries{Values: []float64{nan, -inf}})
+		}},
+		{"Histogram", func() *EmbNode {
+			return page.Histogram("x", []float64{nan, inf, -inf}, HistogramOptions{})
+		}},
+		{"HistogramLog", func() *EmbNode {
+			return page.Histogram("x", []float64{1, nan, inf, 100}, HistogramOptions{LogScale: true})
+		}},
+	}
+	for _, test := range tests {
+		v := test.chart().render()
+		svg := v[:strings.Index(v, "<div class='is-hidden'>")]
+		if strings.Contains(svg, "NaN") || strings.Contains(svg, "Inf") || !strings.Contains(svg, "<line ") {
+			t.Error("For", test.name, "expected axes without non-finite coordinates", "got", svg)
+		}
+	}
+	v := page.LineChart("x", Series{Points: []Point{{0, 1}, {1, 2}, {2, inf}, {3, 1}, {4, 2}}}).render()
+	if strings.Count(v, "<polyline ") != 2 {
+		t.Error("For", "TestChartsNonFinite", "expected line broken at infinite value", "got", v)
+	}
+}
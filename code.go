@@ -0,0 +1,280 @@
+package embgui
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// codeLang describes how a language is split into tokens
+// keys marks strings followed by a colon (JSON) or words before a colon at line start (YAML)
+type codeLang struct {
+	lineComments    []string
+	blockComment    [2]string
+	quotes          string
+	keywords        map[string]bool
+	literals        map[string]bool
+	caseInsensitive bool
+	variables       bool
+	keys            bool
+	yaml            bool
+}
+
+// words turns space-separated words into a set
+func words(list string) map[string]bool {
+	set := map[string]bool{}
+	for _, w := range strings.Fields(list) {
+		set[w] = true
+	}
+	return set
+}
+
+// codeLangs are languages known to Code(), with aliases
+var codeLangs = map[string]*codeLang{}
+
+func init() {
+	golang := &codeLang{lineComments: []string{"//"}, blockComment: [2]string{"/*", "*/"}, quotes: "\"'`",
+		keywords: words("break case chan const continue default defer else fallthrough for func go goto if import " +
+			"interface map package range return select struct switch type var"),
+		literals: words("true false nil iota")}
+	sql := &codeLang{lineComments: []string{"--"}, blockComment: [2]string{"/*", "*/"}, quotes: "'\"", caseInsensitive: true,
+		keywords: words("select from where and or not insert into values update set delete create table drop alter add " +
+			"column index on join left right inner outer full cross group by order having limit offset as distinct union " +
+			"all in is like between case when then else end exists primary key foreign references default unique " +
+			"begin commit rollback returning with asc desc if view grant revoke"),
+		literals: words("true false null")}
+	shell := &codeLang{lineComments: []string{"#"}, quotes: "\"'", variables: true,
+		keywords: words("if then else elif fi for while until do done case esac in function return export local " +
+			"readonly cd echo exit set unset source shift trap")}
+	json := &codeLang{quotes: "\"", keys: true, literals: words("true false null")}
+	yaml := &codeLang{lineComments: []string{"#"}, quotes: "\"'", keys: true, yaml: true,
+		literals: words("true false null yes no on off True False Null Yes No On Off TRUE FALSE NULL ~")}
+	for names, lang := range map[string]*codeLang{"go golang": golang, "sql": sql, "sh shell bash": shell,
+		"json": json, "yaml yml": yaml} {
+		for _, name := range strings.Fields(names) {
+			codeLangs[name] = lang
+		}
+	}
+}
+
+// codeToken is a piece of code with a class (empty for plain text)
+type codeToken struct {
+	class string
+	text  string
+}
+
+// yamlKey matches a YAML key at the beginning of a line (after indentation and list markers)
+var yamlKey = regexp.MustCompile(`(?m)\A((?:- +)*)([\w.\-/ ]+?|"[^"]*"|'[^']*') *:( |$)`)
+
+// isWordChar tells if a byte can be a part of an identifier
+func isWordChar(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// tokenize splits code into tokens, adjacent plain text is merged
+func (lang *codeLang) tokenize(text string) []codeToken {
+	var tokens []codeToken
+	// plain is where pending plain text starts, it's sliced from text once a token ends it
+	plain := 0
+	emit := func(class string, from int, to int) {
+		if class == "" {
+			return
+		}
+		if plain < from {
+			tokens = append(tokens, codeToken{"", text[plain:from]})
+		}
+		tokens = append(tokens, codeToken{class, text[from:to]})
+		plain = to
+	}
+	lineStart := true
+	for i := 0; i < len(text); {
+		c := text[i]
+		rest := text[i:]
+		if lineStart && lang.yaml {
+			indent := len(rest) - len(strings.TrimLeft(rest, " \t"))
+			if m := yamlKey.FindStringSubmatchIndex(rest[indent:]); m != nil {
+				emit("embgui-key", i+indent+m[4], i+indent+m[5])
+				i += indent + m[5]
+				lineStart = false
+				continue
+			}
+		}
+		lineStart = c == '\n'
+		end := 1
+		class := ""
+		switch {
+		case lang.startsComment(text, i):
+			class = "embgui-com"
+			end = strings.IndexByte(rest, '\n')
+			if end < 0 {
+				end = len(rest)
+			}
+		case lang.blockComment[0] != "" && strings.HasPrefix(rest, lang.blockComment[0]):
+			class = "embgui-com"
+			end = strings.Index(rest[len(lang.blockComment[0]):], lang.blockComment[1])
+			if end < 0 {
+				end = len(rest)
+			} else {
+				end += len(lang.blockComment[0]) + len(lang.blockComment[1])
+			}
+		case strings.IndexByte(lang.quotes, c) >= 0:
+			class = "embgui-str"
+			end = closingQuote(rest)
+			if lang.keys && !lang.yaml && strings.HasPrefix(strings.TrimLeft(rest[end:], " \t"), ":") {
+				class = "embgui-key"
+			}
+		case c >= '0' && c <= '9':
+			class = "embgui-num"
+			for end < len(rest) && (isWordChar(rest[end]) || rest[end] == '.' ||
+				((rest[end] == '+' || rest[end] == '-') && (rest[end-1] == 'e' || rest[end-1] == 'E'))) {
+				end++
+			}
+		case lang.variables && c == '$' && len(rest) > 1 && rest[1] == '{':
+			class = "embgui-var"
+			if end = strings.IndexByte(rest, '}') + 1; end == 0 {
+				end = len(rest)
+			}
+		case lang.variables && c == '$' && len(rest) > 1 && (isWordChar(rest[1]) || strings.IndexByte("?@#*!$", rest[1]) >= 0):
+			class = "embgui-var"
+			end = 2
+			for isWordChar(rest[1]) && end < len(rest) && isWordChar(rest[end]) {
+				end++
+			}
+		case isWordChar(c):
+			for end < len(rest) && isWordChar(rest[end]) {
+				end++
+			}
+			word := rest[:end]
+			if lang.caseInsensitive {
+				word = strings.ToLower(word)
+			}
+			switch {
+			case lang.keywords[word]:
+				class = "embgui-kw"
+			case lang.literals[word]:
+				class = "embgui-lit"
+			}
+		case c == '~' && lang.literals["~"]:
+			class = "embgui-lit"
+		}
+		emit(class, i, i+end)
+		i += end
+	}
+	if plain < len(text) {
+		tokens = append(tokens, codeToken{"", text[plain:]})
+	}
+	return tokens
+}
+
+// startsComment tells if a line comment starts at i, # starts a comment only at the beginning of a word
+func (lang *codeLang) startsComment(text string, i int) bool {
+	for _, prefix := range lang.lineComments {
+		if !strings.HasPrefix(text[i:], prefix) {
+			continue
+		}
+		if prefix != "#" || i == 0 || strings.IndexByte(" \t\n", text[i-1]) >= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// closingQuote returns length of a quoted string at the beginning of s
+// backslash escapes are skipped (except in Go raw strings), so are doubled single quotes (SQL and YAML)
+// only raw strings may span lines
+func closingQuote(s string) int {
+	quote := s[0]
+	for i := 1; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && quote != '`', quote == '\'' && s[i] == quote && i+1 < len(s) && s[i+1] == quote:
+			i++
+		case s[i] == quote:
+			return i + 1
+		case s[i] == '\n' && quote != '`':
+			return i
+		}
+	}
+	return len(s)
+}
+
+// Code generates a code block with syntax highlighting done on the server side
+// supported languages are json, yaml, sql, go and sh (shell), other languages are shown as plain text
+// tokens are spans with embgui-kw, embgui-str, embgui-num, embgui-com, embgui-key, embgui-lit and embgui-var classes
+//
+//		page.Code("SELECT id FROM users WHERE name = 'root'", "sql")
+func (n *EmbNode) Code(text string, lang string) *EmbNode {
+	return n.code(text, lang, false, nil)
+}
+
+// NumberedCode generates a code block with syntax highlighting and line numbers, see Code()
+// lines with given numbers (starting at 1) are highlighted
+//
+//		page.NumberedCode(source, "go", 12, 13)
+func (n *EmbNode) NumberedCode(text string, lang string, highlight ...int) *EmbNode {
+	return n.code(text, lang, true, highlight)
+}
+
+// HighlightedCode generates a code block with syntax highlighting, see Code()
+// lines with given numbers (starting at 1) are highlighted, line numbers aren't shown
+//
+//		page.HighlightedCode(config, "yaml", 3)
+func (n *EmbNode) HighlightedCode(text string, lang string, highlight ...int) *EmbNode {
+	return n.code(text, lang, false, highlight)
+}
+
+// code renders a code block, every line is a separate span, so it can be numbered and highlighted
+func (n *EmbNode) code(text string, lang string, numbers bool, highlight []int) *EmbNode {
+	text = strings.TrimSuffix(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	var tokens []codeToken
+	if l, ok := codeLangs[strings.ToLower(lang)]; ok {
+		tokens = l.tokenize(text)
+	} else {
+		tokens = []codeToken{{"", text}}
+	}
+	highlighted := map[int]bool{}
+	for _, number := range highlight {
+		highlighted[number] = true
+	}
+	lineCount := strings.Count(text, "\n") + 1
+	width := len(strconv.Itoa(lineCount))
+
+	pre := n.add(&EmbNode{HTMLTag: "pre", Class: "embgui-code"})
+	codeNode := pre.add(&EmbNode{HTMLTag: "code"})
+	if lang != "" {
+		codeNode.Class = "language-" + strings.ToLower(lang)
+	}
+	number := 0
+	var line *EmbNode
+	// newLine ends the current line with a newline and starts the next one
+	newLine := func() {
+		if line != nil {
+			if len(line.Children) > 0 && (!numbers || len(line.Children) > 1) {
+				line.Children[len(line.Children)-1].Text += "\n"
+			} else {
+				line.add(&EmbNode{HTMLTag: "span", Text: "\n"})
+			}
+		}
+		number++
+		line = codeNode.add(&EmbNode{HTMLTag: "span", Class: "embgui-code-line"})
+		if highlighted[number] {
+			line.Class += " is-highlighted"
+		}
+		if numbers {
+			numberText := strconv.Itoa(number)
+			line.add(&EmbNode{HTMLTag: "span", Class: "embgui-code-num",
+				Text: strings.Repeat(" ", width-len(numberText)) + numberText + " "})
+		}
+	}
+	newLine()
+	for _, token := range tokens {
+		for i, part := range strings.Split(token.text, "\n") {
+			if i > 0 {
+				newLine()
+			}
+			if part != "" {
+				line.add(&EmbNode{HTMLTag: "span", Class: token.class, Text: part})
+			}
+		}
+	}
+	return pre
+}
@@ -0,0 +1,85 @@
+package embgui
+
+import (
+	"strings"
+	"testing"
+)
+
+// tokenString formats tokens as class:text, plain text is shown as is
+func tokenString(tokens []codeToken) string {
+	var parts []string
+	for _, t := range tokens {
+		if t.class == "" {
+			parts = append(parts, t.text)
+			continue
+		}
+		parts = append(parts, strings.TrimPrefix(t.class, "embgui-")+":"+t.text)
+	}
+	return strings.Join(parts, "|")
+}
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		lang     string
+		code     string
+		expected string
+	}{
+		{"json", `{"id": 12, "name": "a\"b", "ok": true}`,
+			`{|key:"id"|: |num:12|, |key:"name"|: |str:"a\"b"|, |key:"ok"|: |lit:true|}`},
+		{"yaml", "# users\nusers:\n  - name: root\n    uid: 0 # admin\n    shell: \"/bin/sh\"",
+			"com:# users|\n|key:users|:\n  - |key:name|: root\n    |key:uid|: |num:0| |com:# admin|\n    |key:shell|: |str:\"/bin/sh\""},
+		{"sql", "SELECT id FROM users WHERE name = 'o''k' -- root\nLIMIT 10",
+			"kw:SELECT| id |kw:FROM| users |kw:WHERE| name = |str:'o''k'| |com:-- root|\n|kw:LIMIT| |num:10"},
+		{"go", "func f() string { /* raw */ return `a\nb` // done\n}",
+			"kw:func| f() string { |com:/* raw */| |kw:return| |str:`a\nb`| |com:// done|\n}"},
+		{"sh", "export PATH=$HOME/bin:${PATH} # path\necho \"$1\" a#b",
+			"kw:export| PATH=|var:$HOME|/bin:|var:${PATH}| |com:# path|\n|kw:echo| |str:\"$1\"| a#b"},
+		{"go", "x := 1.5e-3 + 0x1F", "x := |num:1.5e-3| + |num:0x1F"},
+		{"yaml", "  key: [a]", "  |key:key|: [a]"},
+	}
+	// plain text is merged without copying it on every character
+	large := strings.Repeat("[", 200000)
+	if v := codeLangs["json"].tokenize(large); len(v) != 1 || v[0].text != large {
+		t.Error("For", "TestTokenize", "expected a single plain token, got", len(v), "tokens")
+	}
+	for _, test := range tests {
+		v := tokenString(codeLangs[test.lang].tokenize(test.code))
+		if v != test.expected {
+			t.Error(
+				"For", test.lang, test.code,
+				"expected", test.expected,
+				"got", v,
+			)
+		}
+	}
+}
+
+func TestCode(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	page.Code("SELECT 1\n", "SQL")
+	page.NumberedCode("a: 1\n\nb: 2", "yaml", 3)
+	page.Code("<b>", "")
+	page.HighlightedCode("a\nb", "", 2)
+	v := page.render()
+	expectedResult := `<><pre class='embgui-code'><code class='language-sql'><span class='embgui-code-line'>` +
+		`<span class='embgui-kw'>SELECT</span><span> </span><span class='embgui-num'>1</span></span></code></pre>` +
+		`<pre class='embgui-code'><code class='language-yaml'>` +
+		`<span class='embgui-code-line'><span class='embgui-code-num'>1 </span><span class='embgui-key'>a</span>` +
+		`<span>: </span><span class='embgui-num'>1` + "\n" + `</span></span>` +
+		`<span class='embgui-code-line'><span class='embgui-code-num'>2 </span><span>` + "\n" + `</span></span>` +
+		`<span class='embgui-code-line is-highlighted'><span class='embgui-code-num'>3 </span><span class='embgui-key'>b</span>` +
+		`<span>: </span><span class='embgui-num'>2</span></span></code></pre>` +
+		`<pre class='embgui-code'><code><span class='embgui-code-line'><span>&lt;b&gt;</span></span></code></pre>` +
+		`<pre class='embgui-code'><code><span class='embgui-code-line'><span>a` + "\n" + `</span></span>` +
+		`<span class='embgui-code-line is-highlighted'><span>b</span></span></code></pre></>`
+	if v != expectedResult {
+		t.Error(
+			"For", "TestCode",
+			"expected", expectedResult,
+			"got", v,
+		)
+	}
+}
@@ -0,0 +1,65 @@
+package embgui
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Section is a single collapsible section of Accordion component
+// Key identifies the section in a query parameter and is used as its id, Summary is always visible
+// see Accordion()
+type Section struct {
+	Key     string
+	Summary string
+	Content func(content *EmbNode)
+}
+
+// Collapsible generates a section that folds and unfolds without JavaScript (HTML details/summary)
+// it returns section's content, that can hold any other component
+// content is a separate block, so text-based browsers show the summary in its own line above it
+//
+//		page.Collapsible("Goroutine dump", false).Pre(dump, "")
+func (n *EmbNode) Collapsible(summary string, open bool) *EmbNode {
+	return n.collapsible("", summary, open)
+}
+
+// collapsible generates details element with an optional id
+func (n *EmbNode) collapsible(id string, summary string, open bool) *EmbNode {
+	details := n.add(&EmbNode{HTMLTag: "details", Class: "embgui-collapsible", ID: id, Open: open})
+	details.add(&EmbNode{HTMLTag: "summary", Text: summary})
+	return details.add(&EmbNode{HTMLTag: "div", Class: "embgui-collapsible-content"})
+}
+
+// SectionOpen tells if a section was requested open with a query parameter
+// many sections can be opened with repeated or comma-separated values: ?open=env,heap or ?open=env&open=heap
+func SectionOpen(r *http.Request, param string, key string) bool {
+	if r == nil || r.URL == nil || key == "" {
+		return false
+	}
+	for _, value := range r.URL.Query()[param] {
+		for _, k := range strings.Split(value, ",") {
+			if k == key {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Accordion generates a group of collapsible sections attached to each other
+// sections are folded unless requested open with param query parameter (see SectionOpen()),
+// so a link like ?open=heap#heap points at an unfolded section
+//
+//		page.Accordion(r, "open",
+//		embgui.Section{Key: "env", Summary: "Environment", Content: func(c *embgui.EmbNode) { c.Pre(env, "") }},
+//		embgui.Section{Key: "heap", Summary: "Heap profile", Content: showHeap})
+func (n *EmbNode) Accordion(r *http.Request, param string, sections ...Section) *EmbNode {
+	accordion := n.add(&EmbNode{HTMLTag: "div", Class: "embgui-accordion"})
+	for _, section := range sections {
+		content := accordion.collapsible(section.Key, section.Summary, SectionOpen(r, param, section.Key))
+		if section.Content != nil {
+			section.Content(content)
+		}
+	}
+	return accordion
+}
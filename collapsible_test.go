@@ -0,0 +1,64 @@
+package embgui
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCollapsible(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	page.Collapsible("Goroutine dump", true).Pre("goroutine 1 [running]", "")
+	v := page.render()
+	expectedResult := `<><details class='embgui-collapsible' open><summary>Goroutine dump</summary>` +
+		`<div class='embgui-collapsible-content'><pre>goroutine 1 [running]</pre></div></details></>`
+	if v != expectedResult {
+		t.Error(
+			"For", "TestCollapsible",
+			"expected", expectedResult,
+			"got", v,
+		)
+	}
+}
+
+func TestSectionOpen(t *testing.T) {
+	r := httptest.NewRequest("GET", "/debug?open=env,heap&open=gc", nil)
+	for key, expected := range map[string]bool{"env": true, "heap": true, "gc": true, "stack": false, "": false} {
+		if v := SectionOpen(r, "open", key); v != expected {
+			t.Error(
+				"For", key,
+				"expected", expected,
+				"got", v,
+			)
+		}
+	}
+	if SectionOpen(nil, "open", "env") {
+		t.Error("For", "nil request", "expected", false, "got", true)
+	}
+}
+
+func TestAccordion(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	r := httptest.NewRequest("GET", "/debug?open=heap", nil)
+	page.Accordion(r, "open",
+		Section{Key: "env", Summary: "Environment", Content: func(c *EmbNode) { c.P("GOMAXPROCS=4") }},
+		Section{Key: "heap", Summary: "Heap"})
+	v := page.render()
+	expectedResult := `<><div class='embgui-accordion'>` +
+		`<details class='embgui-collapsible' id='env'><summary>Environment</summary>` +
+		`<div class='embgui-collapsible-content'><p>GOMAXPROCS=4</p></div></details>` +
+		`<details class='embgui-collapsible' id='heap' open><summary>Heap</summary>` +
+		`<div class='embgui-collapsible-content'></div></details></div></>`
+	if v != expectedResult {
+		t.Error(
+			"For", "TestAccordion",
+			"expected", expectedResult,
+			"got", v,
+		)
+	}
+}
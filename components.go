@@ -1,5 +1,10 @@
 package embgui
 
+import (
+	"math"
+	"strconv"
+)
+
 // H1 generates <h1> tag
 func (n *EmbNode) H1(text string) *EmbNode {
 	return n.add(&EmbNode{Text: text, HTMLTag: "h1", Class: "title is-1"})
@@ -62,17 +67,65 @@ func (n *EmbNode) TwoColumns() (*EmbNode, *EmbNode) {
 //		embgui.Tile{Title: "71", Subtitle: "new sales"},
 //		embgui.Tile{Title: "90%", Subtitle: "CPU usage"},
 //		embgui.Tile{Title: "71%", Subtitle: "disk free"})
+//
+// tiles may change color when a value crosses thresholds, show a delta and link to a detail page
+//
+//		page.GenTiles(embgui.Tile{Title: "90%", Subtitle: "CPU usage", Value: 90,
+//		Thresholds: &embgui.Thresholds{Warning: 70, Danger: 85}, Previous: 80, ShowDelta: true, Link: "/cpu"})
+//
+// typed values are formatted with page's formatter (see format package)
+//
+//		page.GenTiles(embgui.Tile{Data: format.IECBytes(free), Subtitle: "disk free"})
 func (n *EmbNode) GenTiles(data ...Tile) *EmbNode {
 	parent := n.add(&EmbNode{HTMLTag: "div", Class: "tile is-ancestor"})
+	f := n.formatter()
 	for _, n := range data {
+		if n.Data != nil {
+			if n.Title == "" {
+				n.Title = f.Format(n.Data)
+			}
+			if v, ok := numericValue(n.Data); ok && n.Value == 0 {
+				n.Value = v
+			}
+		}
 		tile := parent.add(&EmbNode{HTMLTag: "div", Class: "tile is-parent"})
 		article := tile.add(&EmbNode{HTMLTag: "article", Class: "tile is-child box"})
+		if n.Thresholds != nil {
+			article.Class = "tile is-child notification " + n.Thresholds.color(n.Value)
+		}
+		if n.Link != "" {
+			article.HTMLTag = "a"
+			article.Href = n.Link
+		}
 		article.add(&EmbNode{HTMLTag: "p", Class: "title", Text: n.Title})
 		article.add(&EmbNode{HTMLTag: "p", Class: "subtitle", Text: n.Subtitle})
+		if n.ShowDelta {
+			article.add(&EmbNode{HTMLTag: "p", Class: "embgui-delta", Text: delta(n.Value, n.Previous)})
+		}
+		if len(n.Trend) > 0 {
+			article.add(&EmbNode{HTMLTag: "p"}).Sparkline(n.Trend)
+		}
 	}
 	return parent
 }
 
+// delta describes a change against a previous value with an arrow, like "▲ +10 (+12.5%)"
+func delta(value float64, previous float64) string {
+	// round float errors away, so 90.3-80 is 10.3
+	diff, _ := strconv.ParseFloat(strconv.FormatFloat(value-previous, 'g', 10, 64), 64)
+	arrow, sign := "▶", ""
+	if diff > 0 {
+		arrow, sign = "▲", "+"
+	} else if diff < 0 {
+		arrow = "▼"
+	}
+	text := arrow + " " + sign + fmtValue(diff)
+	if previous != 0 {
+		text += " (" + sign + strconv.FormatFloat(diff/math.Abs(previous)*100, 'f', 1, 64) + "%)"
+	}
+	return text
+}
+
 // A generates a link
 func (n *EmbNode) A(text string, href string) *EmbNode {
 	return n.add(&EmbNode{Text: text, HTMLTag: "a", Href: href})
@@ -166,6 +219,16 @@ func (n *EmbNode) Td(text string) *EmbNode {
 	return n.add(&EmbNode{HTMLTag: "td", Text: text})
 }
 
+// TdValue table element with a typed value formatted with page's formatter
+// see format package
+//
+//		row.TdValue(format.Bytes(size))
+//		row.TdValue(uptime) // time.Duration
+//		row.TdValue(createdAt) // time.Time, shown like "3 minutes ago"
+func (n *EmbNode) TdValue(value interface{}) *EmbNode {
+	return n.Td(n.formatter().Format(value))
+}
+
 // Ul starts a list
 func (n *EmbNode) Ul() *EmbNode {
 	return n.add(&EmbNode{HTMLTag: "ul"})
@@ -0,0 +1,149 @@
+package embgui
+
+import (
+	"net/http"
+)
+
+// filterFormID is an id of a GET form that submits table filters
+// filter inputs are placed inside the table and bound to the form with form attribute,
+// so row actions (POST forms) aren't nested in another form
+// next tables on the same page get numbered ids (see uniqueID())
+const filterFormID = "embgui-filters"
+
+// Column describes a column of a table with sorting and filtering done on the server side
+// Key is used in ?sort= and ?filter_<key>= query parameters
+// see SortableTableBody() and DataTable()
+type Column struct {
+	Key        string
+	Header     string
+	Sortable   bool
+	Filterable bool
+}
+
+// TableQuery holds sorting, filtering and paging read from query parameters
+// Sort is always a key of a sortable column or empty, so it's safe to map it to ORDER BY
+type TableQuery struct {
+	Sort    string
+	Desc    bool
+	Filters map[string]string
+	Offset  int
+	Limit   int
+}
+
+// DataSource provides rows of a table
+// sorting, filtering and paging is done by the source, usually in a database
+type DataSource interface {
+	Count(q TableQuery) (int, error)
+	Rows(q TableQuery) ([][]string, error)
+}
+
+// filterParam returns query parameter name of column's filter
+func filterParam(key string) string {
+	return "filter_" + key
+}
+
+// NewTableQuery reads ?sort=key&dir=asc|desc and ?filter_<key>= parameters from a request
+// unknown and non-sortable sort keys are ignored, so are filters of non-filterable columns
+// Offset and Limit are left for paging (see DataTable())
+func NewTableQuery(r *http.Request, columns []Column) TableQuery {
+	q := TableQuery{Filters: map[string]string{}}
+	key := queryParam(r, "sort")
+	for _, c := range columns {
+		if c.Sortable && c.Key == key {
+			q.Sort = key
+			q.Desc = queryParam(r, "dir") == "desc"
+		}
+		if value := queryParam(r, filterParam(c.Key)); c.Filterable && value != "" {
+			q.Filters[c.Key] = value
+		}
+	}
+	return q
+}
+
+// SortableTableBody generates table with clickable headers that sort it with ?sort=key&dir=asc|desc
+// and a filter row with GET text inputs, it works without JavaScript and keeps other query parameters
+// except ?page=, so a new sort order starts from the first page
+// it returns tbody, just like GenTableBody(), use NewTableQuery() to read sorting and filters
+//
+//		columns := []embgui.Column{{Key: "name", Header: "Name", Sortable: true, Filterable: true}}
+//		q := embgui.NewTableQuery(r, columns)
+//		tbody := page.SortableTableBody(r, columns)
+//		for _, user := range listUsers(q) { ... }
+func (n *EmbNode) SortableTableBody(r *http.Request, columns []Column) *EmbNode {
+	q := NewTableQuery(r, columns)
+	filterable := false
+	for _, c := range columns {
+		filterable = filterable || c.Filterable
+	}
+	formID := ""
+	if filterable {
+		formID = n.uniqueID(filterFormID)
+		// GET form drops query string of its action, so other parameters are kept with hidden inputs
+		// page is skipped, filtering starts from the first page
+		form := n.add(&EmbNode{HTMLTag: "form", ID: formID, Method: "GET"})
+		skip := map[string]bool{"page": true}
+		for _, c := range columns {
+			skip[filterParam(c.Key)] = true
+		}
+		form.keepQuery(r, skip)
+	}
+	table := &EmbNode{HTMLTag: "table", Class: "table is-narrow is-hoverable is-fullwidth"}
+	head := table.add(&EmbNode{HTMLTag: "thead"})
+	headRow := head.add(&EmbNode{HTMLTag: "tr"})
+	for _, c := range columns {
+		th := headRow.add(&EmbNode{HTMLTag: "th"})
+		if !c.Sortable {
+			th.Text = c.Header
+			continue
+		}
+		dir, text := "asc", c.Header
+		if q.Sort == c.Key && !q.Desc {
+			dir, text = "desc", c.Header+" ▲"
+		} else if q.Sort == c.Key {
+			text = c.Header + " ▼"
+		}
+		th.add(&EmbNode{HTMLTag: "a", Href: queryLink(r, "sort", c.Key, "dir", dir, "page", ""), Text: text})
+	}
+	if filterable {
+		filterRow := head.add(&EmbNode{HTMLTag: "tr"})
+		for i, c := range columns {
+			th := filterRow.add(&EmbNode{HTMLTag: "th"})
+			if c.Filterable {
+				th.add(&EmbNode{HTMLTag: "input", Type: "text", Class: "input is-small", FormID: formID,
+					Name: filterParam(c.Key), Value: q.Filters[c.Key], Placeholder: c.Header})
+			}
+			if i == len(columns)-1 {
+				th.add(&EmbNode{HTMLTag: "button", Type: "submit", Class: "button is-small is-info", FormID: formID, Text: "Filter"})
+			}
+		}
+	}
+	tbody := table.add(&EmbNode{HTMLTag: "tbody"})
+	n.add(table)
+	return tbody
+}
+
+// DataTable generates sortable and filterable table (see SortableTableBody()) with pagination (see Pagination())
+// rows are pulled from a data source, that gets sorting, filters, offset and limit in TableQuery
+func (n *EmbNode) DataTable(r *http.Request, columns []Column, source DataSource, perPage int) (*EmbNode, error) {
+	q := NewTableQuery(r, columns)
+	paging := NewPaging(r, perPage, 10*perPage)
+	total, err := source.Count(q)
+	if err != nil {
+		return nil, err
+	}
+	paging.SetTotal(total)
+	q.Offset, q.Limit = paging.Offset(), paging.Limit()
+	rows, err := source.Rows(q)
+	if err != nil {
+		return nil, err
+	}
+	tbody := n.SortableTableBody(r, columns)
+	for _, cells := range rows {
+		row := tbody.Tr()
+		for _, cell := range cells {
+			row.Td(cell)
+		}
+	}
+	n.Pagination(paging)
+	return tbody, nil
+}
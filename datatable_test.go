@@ -0,0 +1,112 @@
+package embgui
+
+import (
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// testSource is a data source of numbered rows
+type testSource struct {
+	total int
+	query TableQuery
+}
+
+func (s *testSource) Count(q TableQuery) (int, error) {
+	return s.total, nil
+}
+
+func (s *testSource) Rows(q TableQuery) ([][]string, error) {
+	s.query = q
+	return [][]string{{"john", "smith"}}, nil
+}
+
+var testColumns = []Column{
+	{Key: "name", Header: "Name", Sortable: true, Filterable: true},
+	{Key: "surname", Header: "Surname", Sortable: true},
+	{Key: "email", Header: "Email", Filterable: false},
+}
+
+func TestTableQuery(t *testing.T) {
+	r := httptest.NewRequest("GET", "/users?sort=email&dir=desc&filter_name=jo&filter_surname=x", nil)
+	q := NewTableQuery(r, testColumns)
+	expected := TableQuery{Filters: map[string]string{"name": "jo"}}
+	if !reflect.DeepEqual(q, expected) {
+		t.Error("For", "TestTableQuery", "expected", expected, "got", q)
+	}
+	q = NewTableQuery(httptest.NewRequest("GET", "/users?sort=name&dir=desc", nil), testColumns)
+	if q.Sort != "name" || !q.Desc {
+		t.Error("For", "TestTableQuery", "expected descending sort by name, got", q)
+	}
+}
+
+func TestSortableTableBody(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	r := httptest.NewRequest("GET", "/users?sort=name&tab=all&page=3&filter_name=jo", nil)
+	page.SortableTableBody(r, testColumns)
+	v := page.render()
+	expectedResult := `<><form id='embgui-filters' action='/users' method='GET'>` +
+		`<input type='hidden' name='sort' value='name'></input><input type='hidden' name='tab' value='all'></input></form>` +
+		`<table class='table is-narrow is-hoverable is-fullwidth'><thead><tr>` +
+		`<th><a href='/users?dir=desc&amp;filter_name=jo&amp;sort=name&amp;tab=all'>Name ▲</a></th>` +
+		`<th><a href='/users?dir=asc&amp;filter_name=jo&amp;sort=surname&amp;tab=all'>Surname</a></th>` +
+		`<th>Email</th></tr><tr><th><input class='input is-small' type='text' name='filter_name' value='jo' form='embgui-filters' placeholder='Name'></input></th>` +
+		`<th></th><th><button class='button is-small is-info' type='submit' form='embgui-filters'>Filter</button></th></tr></thead><tbody></tbody></table></>`
+	if v != expectedResult {
+		t.Error(
+			"For", "TestSortableTableBody",
+			"expected", expectedResult,
+			"got", v,
+		)
+	}
+}
+
+func TestSortableTableBodyIDs(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	r := httptest.NewRequest("GET", "/users", nil)
+	page.SortableTableBody(r, testColumns)
+	_, right := page.TwoColumns()
+	right.SortableTableBody(r, testColumns)
+	v := page.render()
+	for _, str := range []string{`<form id='embgui-filters' `, `<form id='embgui-filters-2' `,
+		`form='embgui-filters' placeholder='Name'>`, `form='embgui-filters-2' placeholder='Name'>`} {
+		if strings.Count(v, str) != 1 {
+			t.Error("For", "TestSortableTableBodyIDs", "expected once", str, "got", v)
+		}
+	}
+	other := preparePage()
+	other.SortableTableBody(r, testColumns)
+	if v := other.render(); !strings.Contains(v, `<form id='embgui-filters' `) {
+		t.Error("For", "TestSortableTableBodyIDs", "expected ids to start over on a new page, got", v)
+	}
+}
+
+func TestDataTable(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	source := &testSource{total: 45}
+	r := httptest.NewRequest("GET", "/users?sort=surname&page=9&filter_name=jo", nil)
+	tbody, err := page.DataTable(r, testColumns, source, 20)
+	if err != nil {
+		t.Error("For", "TestDataTable", "Error:", err.Error())
+	}
+	expected := TableQuery{Sort: "surname", Filters: map[string]string{"name": "jo"}, Offset: 40, Limit: 20}
+	if !reflect.DeepEqual(source.query, expected) {
+		t.Error("For", "TestDataTable", "expected", expected, "got", source.query)
+	}
+	if v := tbody.render(); v != `<tbody><tr><td>john</td><td>smith</td></tr></tbody>` {
+		t.Error("For", "TestDataTable", "unexpected rows", v)
+	}
+	if v := page.render(); strings.Contains(v, `<a class='pagination-link is-current' href='/users?filter_name=jo&amp;page=3&amp;sort=surname'>3</a>`) == false {
+		t.Error("For", "TestDataTable", "expected pagination with kept parameters, got", v)
+	}
+}
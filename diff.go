@@ -0,0 +1,266 @@
+package embgui
+
+import (
+	"strconv"
+	"strings"
+)
+
+// DiffMode selects the layout of Diff
+type DiffMode int
+
+const (
+	// DiffUnified shows removed and added lines one below another, like diff -u
+	DiffUnified DiffMode = iota
+	// DiffSideBySide shows the old text on the left and the new text on the right
+	DiffSideBySide
+)
+
+// diffContext is a number of unchanged lines shown around every change
+const diffContext = 3
+
+// diffLine is a single line of a diff, op is ' ' for unchanged, '-' for removed and '+' for added lines
+// line numbers start at 1, 0 means the line doesn't exist in a given text
+type diffLine struct {
+	op   byte
+	text string
+	old  int
+	new  int
+}
+
+// splitLines splits text into lines, the final newline doesn't start a new line
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
+
+// Myers' algorithm needs O((N+M)·D) time and O(D²) memory, so big or very different texts aren't compared
+// maxDiffEdits limits the number of removed and added lines, maxDiffLines limits lines left after
+// common beginning and end of texts are skipped
+const (
+	maxDiffEdits = 1000
+	maxDiffLines = 20000
+)
+
+// diffLines compares lines and returns the shortest edit script
+// common lines are listed once, removed lines come before added lines within a change
+// it returns false if texts are too big or too different to compare (see maxDiffEdits)
+func diffLines(a []string, b []string) ([]diffLine, bool) {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+	if len(a)+len(b)-2*(prefix+suffix) > maxDiffLines {
+		return nil, false
+	}
+	middle, ok := myersDiff(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])
+	if !ok {
+		return nil, false
+	}
+	lines := make([]diffLine, 0, prefix+len(middle)+suffix)
+	for i := 0; i < prefix; i++ {
+		lines = append(lines, diffLine{op: ' ', text: a[i], old: i + 1, new: i + 1})
+	}
+	for _, l := range middle {
+		if l.old > 0 {
+			l.old += prefix
+		}
+		if l.new > 0 {
+			l.new += prefix
+		}
+		lines = append(lines, l)
+	}
+	for i := suffix; i > 0; i-- {
+		lines = append(lines, diffLine{op: ' ', text: a[len(a)-i], old: len(a) - i + 1, new: len(b) - i + 1})
+	}
+	return lines, true
+}
+
+// myersDiff compares lines with Myers' O(ND) algorithm, it returns false if it needs more than maxDiffEdits edits
+func myersDiff(a []string, b []string) ([]diffLine, bool) {
+	n, m := len(a), len(b)
+	maxD := n + m
+	if maxD > maxDiffEdits {
+		maxD = maxDiffEdits
+	}
+	offset := maxD + 1
+	v := make([]int, 2*offset+1)
+	// trace keeps furthest reaching paths of diagonals -d..d before every step d,
+	// they're needed to walk the path back
+	var trace [][]int
+	found := false
+search:
+	for d := 0; d <= maxD; d++ {
+		trace = append(trace, append([]int(nil), v[offset-d:offset+d+1]...))
+		for k := -d; k <= d; k += 2 {
+			x := v[offset+k-1] + 1
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x, y = x+1, y+1
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				found = true
+				break search
+			}
+		}
+	}
+	if !found {
+		return nil, false
+	}
+	var reversed []diffLine
+	x, y := n, m
+	for d := len(trace) - 1; d >= 0; d-- {
+		// furthest reaching x of diagonal k before step d
+		reach := func(k int) int {
+			return trace[d][k+d]
+		}
+		k := x - y
+		prevX, prevY := 0, 0
+		if d > 0 {
+			prevK := k - 1
+			if k == -d || (k != d && reach(k-1) < reach(k+1)) {
+				prevK = k + 1
+			}
+			prevX = reach(prevK)
+			prevY = prevX - prevK
+		}
+		for x > prevX && y > prevY {
+			reversed = append(reversed, diffLine{op: ' ', text: a[x-1], old: x, new: y})
+			x, y = x-1, y-1
+		}
+		if d == 0 {
+			break
+		}
+		if x == prevX {
+			reversed = append(reversed, diffLine{op: '+', text: b[y-1], new: y})
+		} else {
+			reversed = append(reversed, diffLine{op: '-', text: a[x-1], old: x})
+		}
+		x, y = prevX, prevY
+	}
+	lines := make([]diffLine, len(reversed))
+	for i, l := range reversed {
+		lines[len(reversed)-1-i] = l
+	}
+	return lines, true
+}
+
+// visibleLines marks lines that are shown: changes and diffContext unchanged lines around them
+// runs of unchanged lines that would hide just a single line are shown too
+func visibleLines(lines []diffLine) []bool {
+	visible := make([]bool, len(lines))
+	for i, l := range lines {
+		if l.op == ' ' {
+			continue
+		}
+		for j := i - diffContext; j <= i+diffContext; j++ {
+			if j >= 0 && j < len(lines) {
+				visible[j] = true
+			}
+		}
+	}
+	for i := 0; i < len(lines); i++ {
+		if !visible[i] && (i+1 >= len(lines) || visible[i+1]) && (i == 0 || visible[i-1]) {
+			visible[i] = true
+		}
+	}
+	return visible
+}
+
+// lineNumber formats a line number, 0 is an empty cell
+func lineNumber(number int) string {
+	if number == 0 {
+		return ""
+	}
+	return strconv.Itoa(number)
+}
+
+// diffRowClass returns a class of a row with a given operation
+func diffRowClass(op byte) string {
+	switch op {
+	case '-':
+		return "embgui-diff-del"
+	case '+':
+		return "embgui-diff-add"
+	}
+	return ""
+}
+
+// Diff generates a line-based diff of two texts, the diff is computed in-library (Myers' algorithm)
+// texts that need more than 1000 removed and added lines (or more than 20000 lines around changes)
+// are only reported as different
+// removed and added lines are highlighted and numbered, long unchanged parts are collapsed
+// lines are prefixed with - and +, so the diff is readable in text-based browsers too
+//
+//		page.Diff(oldConfig, newConfig, embgui.DiffSideBySide)
+func (n *EmbNode) Diff(a string, b string, mode DiffMode) *EmbNode {
+	lines, ok := diffLines(splitLines(a), splitLines(b))
+	visible := visibleLines(lines)
+	columns := 3
+	if mode == DiffSideBySide {
+		columns = 4
+	}
+	table := n.add(&EmbNode{HTMLTag: "table", Class: "table is-narrow is-fullwidth embgui-diff"})
+	tbody := table.add(&EmbNode{HTMLTag: "tbody"})
+	if !ok {
+		tbody.add(&EmbNode{HTMLTag: "tr", Class: "embgui-diff-skip"}).
+			add(&EmbNode{HTMLTag: "td", Colspan: columns, Text: "texts differ too much to show a diff"})
+	}
+	for i := 0; i < len(lines); {
+		if !visible[i] {
+			hidden := 0
+			for ; i < len(lines) && !visible[i]; i++ {
+				hidden++
+			}
+			tbody.add(&EmbNode{HTMLTag: "tr", Class: "embgui-diff-skip"}).
+				add(&EmbNode{HTMLTag: "td", Colspan: columns, Text: "⋯ " + plural(hidden, "unchanged line")})
+			continue
+		}
+		if mode == DiffUnified || lines[i].op == ' ' {
+			l := lines[i]
+			row := tbody.add(&EmbNode{HTMLTag: "tr", Class: diffRowClass(l.op)})
+			row.add(&EmbNode{HTMLTag: "td", Class: "embgui-diff-num", Text: lineNumber(l.old)})
+			if mode == DiffUnified {
+				row.add(&EmbNode{HTMLTag: "td", Class: "embgui-diff-num", Text: lineNumber(l.new)})
+				row.add(&EmbNode{HTMLTag: "td", Text: string(l.op) + " " + l.text})
+			} else {
+				row.add(&EmbNode{HTMLTag: "td", Text: "  " + l.text})
+				row.add(&EmbNode{HTMLTag: "td", Class: "embgui-diff-num", Text: lineNumber(l.new)})
+				row.add(&EmbNode{HTMLTag: "td", Text: "  " + l.text})
+			}
+			i++
+			continue
+		}
+		// side by side, removed lines are paired with added lines of the same change
+		var removed, added []diffLine
+		for ; i < len(lines) && lines[i].op == '-'; i++ {
+			removed = append(removed, lines[i])
+		}
+		for ; i < len(lines) && lines[i].op == '+'; i++ {
+			added = append(added, lines[i])
+		}
+		for j := 0; j < len(removed) || j < len(added); j++ {
+			row := tbody.add(&EmbNode{HTMLTag: "tr"})
+			for _, side := range [][]diffLine{removed, added} {
+				if j >= len(side) {
+					row.add(&EmbNode{HTMLTag: "td", Class: "embgui-diff-num embgui-diff-empty"})
+					row.add(&EmbNode{HTMLTag: "td", Class: "embgui-diff-empty"})
+					continue
+				}
+				l := side[j]
+				row.add(&EmbNode{HTMLTag: "td", Class: "embgui-diff-num " + diffRowClass(l.op), Text: lineNumber(l.old + l.new)})
+				row.add(&EmbNode{HTMLTag: "td", Class: diffRowClass(l.op), Text: string(l.op) + " " + l.text})
+			}
+		}
+	}
+	return table
+}
@@ -0,0 +1,143 @@
+package embgui
+
+import (
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// applyDiff rebuilds both texts from a diff
+func applyDiff(lines []diffLine) ([]string, []string) {
+	var a, b []string
+	for _, l := range lines {
+		if l.op != '+' {
+			a = append(a, l.text)
+		}
+		if l.op != '-' {
+			b = append(b, l.text)
+		}
+	}
+	return a, b
+}
+
+func TestDiffLines(t *testing.T) {
+	a := splitLines("a\nb\nc\na\nb\nb\na\n")
+	b := splitLines("c\nb\na\nb\na\nc")
+	lines, _ := diffLines(a, b)
+	var script []string
+	edits := 0
+	for _, l := range lines {
+		script = append(script, string(l.op)+l.text)
+		if l.op != ' ' {
+			edits++
+		}
+	}
+	// the classic example from Myers' paper has the shortest edit script of 5 edits
+	if edits != 5 {
+		t.Error("For", "TestDiffLines", "expected 5 edits, got", strings.Join(script, " "))
+	}
+	gotA, gotB := applyDiff(lines)
+	if strings.Join(gotA, "\n") != strings.Join(a, "\n") || strings.Join(gotB, "\n") != strings.Join(b, "\n") {
+		t.Error("For", "TestDiffLines", "diff doesn't rebuild texts:", strings.Join(script, " "))
+	}
+	random := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		a, b := make([]string, random.Intn(12)), make([]string, random.Intn(12))
+		for j := range a {
+			a[j] = string(rune('a' + random.Intn(3)))
+		}
+		for j := range b {
+			b[j] = string(rune('a' + random.Intn(3)))
+		}
+		lines, _ := diffLines(a, b)
+		gotA, gotB := applyDiff(lines)
+		if strings.Join(gotA, "") != strings.Join(a, "") || strings.Join(gotB, "") != strings.Join(b, "") {
+			t.Error("For", strings.Join(a, ""), strings.Join(b, ""), "diff doesn't rebuild texts")
+		}
+	}
+}
+
+func TestDiffUnified(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	page.Diff("1\n2\n3\n4\n5\n6\n7\n8\n9\n", "1\n2\n3\n4\n5\n6\n7\neight\n9\n", DiffUnified)
+	v := page.render()
+	expectedResult := `<><table class='table is-narrow is-fullwidth embgui-diff'><tbody>` +
+		`<tr class='embgui-diff-skip'><td colspan='3'>⋯ 4 unchanged lines</td></tr>` +
+		`<tr><td class='embgui-diff-num'>5</td><td class='embgui-diff-num'>5</td><td>  5</td></tr>` +
+		`<tr><td class='embgui-diff-num'>6</td><td class='embgui-diff-num'>6</td><td>  6</td></tr>` +
+		`<tr><td class='embgui-diff-num'>7</td><td class='embgui-diff-num'>7</td><td>  7</td></tr>` +
+		`<tr class='embgui-diff-del'><td class='embgui-diff-num'>8</td><td class='embgui-diff-num'></td><td>- 8</td></tr>` +
+		`<tr class='embgui-diff-add'><td class='embgui-diff-num'></td><td class='embgui-diff-num'>8</td><td>+ eight</td></tr>` +
+		`<tr><td class='embgui-diff-num'>9</td><td class='embgui-diff-num'>9</td><td>  9</td></tr>` +
+		`</tbody></table></>`
+	if v != expectedResult {
+		t.Error(
+			"For", "TestDiffUnified",
+			"expected", expectedResult,
+			"got", v,
+		)
+	}
+}
+
+func TestDiffSideBySide(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	page.Diff("a\nb\nc", "a\nx\ny\nc", DiffSideBySide)
+	v := page.render()
+	expectedResult := `<><table class='table is-narrow is-fullwidth embgui-diff'><tbody>` +
+		`<tr><td class='embgui-diff-num'>1</td><td>  a</td><td class='embgui-diff-num'>1</td><td>  a</td></tr>` +
+		`<tr><td class='embgui-diff-num embgui-diff-del'>2</td><td class='embgui-diff-del'>- b</td>` +
+		`<td class='embgui-diff-num embgui-diff-add'>2</td><td class='embgui-diff-add'>+ x</td></tr>` +
+		`<tr><td class='embgui-diff-num embgui-diff-empty'></td><td class='embgui-diff-empty'></td>` +
+		`<td class='embgui-diff-num embgui-diff-add'>3</td><td class='embgui-diff-add'>+ y</td></tr>` +
+		`<tr><td class='embgui-diff-num'>3</td><td>  c</td><td class='embgui-diff-num'>4</td><td>  c</td></tr>` +
+		`</tbody></table></>`
+	if v != expectedResult {
+		t.Error(
+			"For", "TestDiffSideBySide",
+			"expected", expectedResult,
+			"got", v,
+		)
+	}
+}
+
+func TestDiffLimit(t *testing.T) {
+	var a, b []string
+	for i := 0; i < 4000; i++ {
+		a, b = append(a, "a"+strconv.Itoa(i)), append(b, "b"+strconv.Itoa(i))
+	}
+	if _, ok := diffLines(a, b); ok {
+		t.Error("For", "TestDiffLimit", "expected texts without common lines to exceed the limit")
+	}
+	if lines, ok := diffLines(a[:400], b[:400]); !ok || len(lines) != 800 {
+		t.Error("For", "TestDiffLimit", "expected 800 edits, got", len(lines))
+	}
+	// common beginning and end don't count into limits
+	big := append(append(append([]string{}, a...), "x"), a...)
+	changed := append(append(append([]string{}, a...), "y"), a...)
+	lines, ok := diffLines(big, changed)
+	if !ok || len(lines) != 8002 || lines[4000].op != '-' || lines[4000].old != 4001 || lines[4001].new != 4001 ||
+		lines[8001].old != 8001 || lines[8001].new != 8001 {
+		t.Error("For", "TestDiffLimit", "expected a single change in the middle of a big text")
+	}
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	v := page.Diff(strings.Join(a, "\n"), strings.Join(b, "\n"), DiffSideBySide).render()
+	expectedResult := `<table class='table is-narrow is-fullwidth embgui-diff'><tbody><tr class='embgui-diff-skip'>` +
+		`<td colspan='4'>texts differ too much to show a diff</td></tr></tbody></table>`
+	if v != expectedResult {
+		t.Error(
+			"For", "TestDiffLimit",
+			"expected", expectedResult,
+			"got", v,
+		)
+	}
+}
@@ -5,22 +5,54 @@ import (
 	"errors"
 	"fmt"
 	"html"
+	"reflect"
 	"strconv"
 	"strings"
+
+	"github.com/inteliwise/embgui/format"
 )
 
 // Tile represents single tile
 // https://bulma.io/documentation/layout/tiles/
+// Trend is optional, it's drawn as a sparkline below the subtitle
+// Value is a number behind the Title, it colors the tile if Thresholds are set
+// and it's compared with Previous to show a delta
+// Link makes the whole tile a link to a detail page
+// Data is a typed value (see format package), it's formatted into the Title if Title is empty
+// and used as Value if Value is 0 and Data is a number
 // see GenTiles()
 type Tile struct {
-	Title    string
-	Subtitle string
+	Data       interface{}
+	Title      string
+	Subtitle   string
+	Trend      []float64
+	Value      float64
+	Thresholds *Thresholds
+	Previous   float64
+	ShowDelta  bool
+	Link       string
+}
+
+// Thresholds pick bulma's color of a tile or a gauge: values reaching Warning are yellow,
+// values reaching Danger are red and other values are green
+// HigherIsBetter flips the scale for values like free disk space,
+// so values at or below Danger are red and values at or below Warning are yellow
+// any number is a valid threshold, set Warning equal to Danger to skip the yellow range
+//
+//		embgui.Thresholds{Warning: 1, Danger: 1}                         // errors count
+//		embgui.Thresholds{Warning: 10, Danger: 0, HigherIsBetter: true} // free space
+type Thresholds struct {
+	Warning        float64
+	Danger         float64
+	HigherIsBetter bool
 }
 
 // EmbNode is a HTML element
 type EmbNode struct {
 	Text        string
 	Href        string
+	Src         string
+	Alt         string
 	Action      string
 	Method      string
 	HTMLTag     string
@@ -30,23 +62,32 @@ type EmbNode struct {
 	Name        string
 	ID          string
 	Enctype     string
+	FormID      string
 	Placeholder string
 	Value       string
+	Max         string
 	Rows        int
+	Colspan     int
 	Unsafe      bool
+	Disabled    bool
+	Open        bool
 	Root        bool
 	menuOption  string
+	refresh     int
+	ids         map[string]int
 	GUIConfig   *EmbGUI
 	Children    []*EmbNode
 }
 
 // EmbGUI is a HTML page, with one root EmbNode with many children
+// Format is used to format typed values (see TdValue() and Tile.Data)
 type EmbGUI struct {
 	CSS        string
 	Size       string
 	NavTheme   string
 	NavLink    string
 	CustomHead string
+	Format     format.Formatter
 	title      string
 	cssLink    string
 	menu       []MenuItem
@@ -80,7 +121,7 @@ func New(title string, cssLink string, menu []MenuItem) (*EmbGUI, error) {
 // it's injected into template, so usually you need to create one root per view
 // menuOption will be made active on navbar
 func (gui *EmbGUI) NewRoot(menuOption string) *EmbNode {
-	return &EmbNode{Root: true, GUIConfig: gui, menuOption: menuOption}
+	return &EmbNode{Root: true, GUIConfig: gui, menuOption: menuOption, ids: map[string]int{}}
 }
 
 // attr renders HTML HTMLTag attribute
@@ -102,6 +143,8 @@ func (n *EmbNode) startHTMLTag() string {
 	buffer.WriteString(n.HTMLTag)
 	attr("class", n.Class, &buffer)
 	attr("href", n.Href, &buffer)
+	attr("src", n.Src, &buffer)
+	attr("alt", n.Alt, &buffer)
 	attr("id", n.ID, &buffer)
 	attr("action", n.Action, &buffer)
 	attr("method", n.Method, &buffer)
@@ -109,11 +152,22 @@ func (n *EmbNode) startHTMLTag() string {
 	attr("style", n.Style, &buffer)
 	attr("name", n.Name, &buffer)
 	attr("value", n.Value, &buffer)
+	attr("max", n.Max, &buffer)
 	attr("enctype", n.Enctype, &buffer)
+	attr("form", n.FormID, &buffer)
 	if n.HTMLTag == "textarea" {
 		attr("rows", strconv.Itoa(n.Rows), &buffer)
 	}
+	if n.Colspan > 0 {
+		attr("colspan", strconv.Itoa(n.Colspan), &buffer)
+	}
 	attr("placeholder", n.Placeholder, &buffer)
+	if n.Disabled {
+		buffer.WriteString(" disabled")
+	}
+	if n.Open {
+		buffer.WriteString(" open")
+	}
 	buffer.WriteString(">")
 	return buffer.String()
 }
@@ -154,11 +208,68 @@ func (n *EmbNode) renderRoot() string {
 }
 
 // add adds a child to a node
+// the child (and its children) share page's config and generated ids with the parent
 func (n *EmbNode) add(node *EmbNode) *EmbNode {
+	if node.GUIConfig == nil && n.GUIConfig != nil {
+		node.setConfig(n.GUIConfig, n.ids)
+	}
 	n.Children = append(n.Children, node)
 	return node
 }
 
+// setConfig sets page's config and generated ids of a node and its children
+func (n *EmbNode) setConfig(gui *EmbGUI, ids map[string]int) {
+	n.GUIConfig = gui
+	n.ids = ids
+	for _, child := range n.Children {
+		if child.GUIConfig == nil {
+			child.setConfig(gui, ids)
+		}
+	}
+}
+
+// uniqueID returns an id that is unique within a page
+// the first id is the prefix itself, next ones get a number: prefix-2, prefix-3...
+// nodes outside of a page always get the prefix
+func (n *EmbNode) uniqueID(prefix string) string {
+	if n.ids == nil {
+		return prefix
+	}
+	n.ids[prefix]++
+	if n.ids[prefix] == 1 {
+		return prefix
+	}
+	return prefix + "-" + strconv.Itoa(n.ids[prefix])
+}
+
+// formatter returns page's formatter, or a default one for nodes outside of a page
+func (n *EmbNode) formatter() format.Formatter {
+	if n.GUIConfig == nil {
+		return format.Default
+	}
+	return n.GUIConfig.Format
+}
+
+// numericValue converts numeric types (including typed values from format package) to float64
+func numericValue(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case format.Fixed:
+		return v.Value, true
+	case nil:
+		return 0, false
+	}
+	value := reflect.ValueOf(v)
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(value.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(value.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return value.Float(), true
+	}
+	return 0, false
+}
+
 // Render HTML element and its child nodes
 // if you don't want to escape text inside the tag, set Unsafe to true
 func (n *EmbNode) render() string {
@@ -176,6 +287,20 @@ func (n *EmbNode) render() string {
 	return buffer.String()
 }
 
+// Refresh makes the page reload itself every given number of seconds, without JavaScript
+// it's handy for dashboards and logs (see LogView()), it works only for a root element
+func (n *EmbNode) Refresh(seconds int) {
+	n.refresh = seconds
+}
+
+// refreshMeta generates meta tag that reloads the page, or nothing if refresh isn't set
+func (n *EmbNode) refreshMeta() string {
+	if n.refresh <= 0 {
+		return ""
+	}
+	return `<meta http-equiv="refresh" content="` + strconv.Itoa(n.refresh) + `">`
+}
+
 // RenderPage renders template with top-menu, root EmbNode element and its children
 func (n *EmbNode) RenderPage() (string, error) {
 	if n.Root == false {
@@ -187,8 +312,10 @@ func (n *EmbNode) RenderPage() (string, error) {
 		<head>
 			<meta charset="utf-8">
 			<meta name="viewport" content="width=device-width, initial-scale=1">
+			%s
 			<title>%s</title>
 			<link rel="stylesheet" href="%s">
+			<style>%s</style>
 			%s
 		</head>
 		<body>
@@ -215,8 +342,10 @@ func (n *EmbNode) RenderPage() (string, error) {
 			</section>
 		</body>
 	</html>
-	`, n.GUIConfig.title,
+	`, n.refreshMeta(),
+		n.GUIConfig.title,
 		n.GUIConfig.cssLink,
+		styles,
 		n.GUIConfig.CustomHead,
 		n.GUIConfig.NavTheme,
 		n.GUIConfig.NavLink,
@@ -3,6 +3,9 @@ package embgui
 import (
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/inteliwise/embgui/format"
 )
 
 type simpleElementTest struct {
@@ -173,6 +176,80 @@ func TestTiles(t *testing.T) {
 	}
 }
 
+func TestTileThresholds(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	tiles := page.GenTiles(Tile{Title: "90%", Subtitle: "CPU usage", Value: 90.3, Thresholds: &Thresholds{Warning: 70, Danger: 85},
+		Previous: 80, ShowDelta: true, Link: "/cpu"},
+		Tile{Title: "40%", Subtitle: "disk free", Value: 40, Thresholds: &Thresholds{Warning: 30, Danger: 10, HigherIsBetter: true},
+			Previous: 40, ShowDelta: true})
+	v := tiles.render()
+	expectedResult := `<div class='tile is-ancestor'><div class='tile is-parent'>` +
+		`<a class='tile is-child notification is-danger' href='/cpu'><p class='title'>90%</p><p class='subtitle'>CPU usage</p>` +
+		`<p class='embgui-delta'>▲ +10.3 (+12.9%)</p></a></div><div class='tile is-parent'>` +
+		`<article class='tile is-child notification is-success'><p class='title'>40%</p><p class='subtitle'>disk free</p>` +
+		`<p class='embgui-delta'>▶ 0 (0.0%)</p></article></div></div>`
+	if v != expectedResult {
+		t.Error(
+			"For", "TestTileThresholds",
+			"expected", expectedResult,
+			"got", v,
+		)
+	}
+	tests := []struct {
+		value      float64
+		thresholds Thresholds
+		expected   string
+	}{
+		{0, Thresholds{Warning: 1, Danger: 1}, "is-success"},
+		{3, Thresholds{Warning: 1, Danger: 1}, "is-danger"},
+		{-25, Thresholds{Warning: -20, Danger: -10}, "is-success"},
+		{-15, Thresholds{Warning: -20, Danger: -10}, "is-warning"},
+		{-10, Thresholds{Warning: -20, Danger: -10}, "is-danger"},
+		{0, Thresholds{Warning: 10, Danger: 0, HigherIsBetter: true}, "is-danger"},
+		{5, Thresholds{Warning: 10, Danger: 0, HigherIsBetter: true}, "is-warning"},
+		{20, Thresholds{Warning: 10, Danger: 0, HigherIsBetter: true}, "is-success"},
+	}
+	for _, test := range tests {
+		if v := test.thresholds.color(test.value); v != test.expected {
+			t.Error("For", "TestTileThresholds", test.value, test.thresholds, "expected", test.expected, "got", v)
+		}
+	}
+	if v := page.GenTiles(Tile{Title: "0", Value: 0, Thresholds: &Thresholds{Warning: 1, Danger: 1}}).render(); !strings.Contains(v, "notification is-success") {
+		t.Error("For", "TestTileThresholds", "expected green tile for zero errors, got", v)
+	}
+	if v := delta(5, 0); v != "▲ +5" {
+		t.Error("For", "TestTileThresholds", "expected delta without percentage, got", v)
+	}
+}
+
+func TestFormattedValues(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	page.GUIConfig.Format = format.Formatter{Locale: format.German}
+	row := page.GenTableBody([]string{"size", "uptime", "count"}).Tr()
+	row.TdValue(format.IECBytes(1536))
+	row.TdValue(90 * time.Second)
+	row.TdValue(1234567)
+	v := row.render()
+	expectedResult := `<tr><td>1,5 KiB</td><td>1m 30s</td><td>1.234.567</td></tr>`
+	if v != expectedResult {
+		t.Error(
+			"For", "TestFormattedValues",
+			"expected", expectedResult,
+			"got", v,
+		)
+	}
+	v = page.GenTiles(Tile{Data: format.Percent(91.5), Subtitle: "CPU usage", Thresholds: &Thresholds{Warning: 70, Danger: 90}}).render()
+	if strings.Contains(v, `<article class='tile is-child notification is-danger'><p class='title'>91,5%</p>`) == false {
+		t.Error("For", "TestFormattedValues", "expected formatted tile, got", v)
+	}
+}
+
 func TestUnsafeRendering(t *testing.T) {
 	page := preparePage()
 	if page == nil {
@@ -0,0 +1,332 @@
+package embgui
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"encoding/json"
+	"html"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// exportParam is a query parameter that selects export format
+const exportParam = "export"
+
+// exportFormat describes a single export format
+type exportFormat struct {
+	name        string
+	extension   string
+	contentType string
+	write       func(w io.Writer, header []string, rows [][]string) error
+}
+
+// exportFormats are formats available in export links, in order
+var exportFormats = []exportFormat{
+	{"CSV", ".csv", "text/csv; charset=utf-8", writeCSV},
+	{"JSON", ".jsonl", "application/x-ndjson", writeJSONLines},
+	{"XLSX", ".xlsx", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", writeXLSX},
+}
+
+// requestedExport returns export format requested with ?export= parameter
+func requestedExport(r *http.Request) (exportFormat, bool) {
+	name := strings.ToUpper(queryParam(r, exportParam))
+	for _, f := range exportFormats {
+		if f.name == name {
+			return f, true
+		}
+	}
+	return exportFormat{}, false
+}
+
+// ExportLinks generates small buttons that link to the current page with ?export=csv|json|xlsx
+// handle them with WriteExport() or WriteSourceExport()
+func (n *EmbNode) ExportLinks(r *http.Request) *EmbNode {
+	buttons := n.Buttons()
+	for _, f := range exportFormats {
+		buttons.add(&EmbNode{Text: f.name, HTMLTag: "a", Href: queryLink(r, exportParam, strings.ToLower(f.name)),
+			Class: "button is-small"})
+	}
+	return buttons
+}
+
+// WriteExport writes the first table found in node's tree (charts' text fallbacks are skipped) as CSV, JSON lines or XLSX
+// if it was requested with ?export= parameter (see ExportLinks()), it returns false otherwise
+// so the same handler can render the page or export its table
+//
+//		page := ui.NewRoot("Users")
+//		tbody := page.GenTableBody([]string{"name", "surname"})
+//		...
+//		page.ExportLinks(r)
+//		if ok, _ := embgui.WriteExport(w, r, page, "users"); ok {
+//			return
+//		}
+//		html, _ := page.RenderPage()
+func WriteExport(w http.ResponseWriter, r *http.Request, n *EmbNode, filename string) (bool, error) {
+	f, ok := requestedExport(r)
+	if !ok {
+		return false, nil
+	}
+	header, rows := n.tableData()
+	return true, writeExport(w, f, filename, header, rows)
+}
+
+// WriteSourceExport writes all rows of a data source (see DataTable()) as CSV, JSON lines or XLSX
+// if it was requested with ?export= parameter, it returns false otherwise
+// sorting and filters from the request are applied, paging is not
+func WriteSourceExport(w http.ResponseWriter, r *http.Request, columns []Column, source DataSource, filename string) (bool, error) {
+	f, ok := requestedExport(r)
+	if !ok {
+		return false, nil
+	}
+	q := NewTableQuery(r, columns)
+	total, err := source.Count(q)
+	if err != nil {
+		return true, err
+	}
+	q.Limit = total
+	rows, err := source.Rows(q)
+	if err != nil {
+		return true, err
+	}
+	header := make([]string, len(columns))
+	for i, c := range columns {
+		header[i] = c.Header
+	}
+	return true, writeExport(w, f, filename, header, rows)
+}
+
+// writeExport sets headers of a file download and writes the file
+func writeExport(w http.ResponseWriter, f exportFormat, filename string, header []string, rows [][]string) error {
+	filename = strings.NewReplacer(`"`, "", `\`, "", "\n", "", "\r", "").Replace(filename) + f.extension
+	w.Header().Set("Content-Type", f.contentType)
+	w.Header().Set("Content-Disposition", `attachment; filename="`+filename+`"`)
+	return f.write(w, header, rows)
+}
+
+// tableData reads header and rows of a table, or the first table in node's tree
+func (n *EmbNode) tableData() ([]string, [][]string) {
+	table := n
+	if n.HTMLTag != "table" {
+		table = n.find("table")
+	}
+	if table == nil {
+		return nil, nil
+	}
+	var header []string
+	var rows [][]string
+	if thead := table.find("thead"); thead != nil {
+		if tr := thead.find("tr"); tr != nil {
+			for _, th := range tr.Children {
+				// sort indicators of SortableTableBody() are not a part of a header
+				header = append(header, strings.TrimSuffix(strings.TrimSuffix(th.text(), " ▲"), " ▼"))
+			}
+		}
+	}
+	if tbody := table.find("tbody"); tbody != nil {
+		for _, tr := range tbody.Children {
+			var row []string
+			for _, td := range tr.Children {
+				row = append(row, td.text())
+			}
+			rows = append(rows, row)
+		}
+	}
+	return header, rows
+}
+
+// find returns the first node with a given tag in node's tree
+// hidden blocks and charts are skipped, so text fallbacks of charts (see textFallback()) aren't found
+func (n *EmbNode) find(tag string) *EmbNode {
+	for _, child := range n.Children {
+		if child.Class == "is-hidden" || child.Class == "embgui-chart" {
+			continue
+		}
+		if child.HTMLTag == tag {
+			return child
+		}
+		if found := child.find(tag); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// text returns text of a node and its children, forms (like row actions) and hidden blocks are skipped
+func (n *EmbNode) text() string {
+	if n.HTMLTag == "form" || n.Class == "is-hidden" {
+		return ""
+	}
+	text := n.Text
+	if n.Unsafe {
+		text = ""
+	}
+	for _, child := range n.Children {
+		text += child.text()
+	}
+	return strings.TrimSpace(text)
+}
+
+// formulaSafe prefixes a text cell with ' when a spreadsheet would read it as a formula,
+// numbers like "-42" are left unchanged
+func formulaSafe(cell string) string {
+	if cell == "" || !strings.ContainsRune("=+-@\t\r", rune(cell[0])) || isNumber(cell) {
+		return cell
+	}
+	return "'" + cell
+}
+
+// writeCSV writes header and rows as CSV, cells that look like formulas are escaped
+func writeCSV(w io.Writer, header []string, rows [][]string) error {
+	writer := csv.NewWriter(w)
+	for _, row := range append([][]string{header}, rows...) {
+		safe := make([]string, len(row))
+		for i, cell := range row {
+			safe[i] = formulaSafe(cell)
+		}
+		if err := writer.Write(safe); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// writeJSONLines writes every row as a JSON object keyed with headers, one object per line
+func writeJSONLines(w io.Writer, header []string, rows [][]string) error {
+	for _, row := range rows {
+		var buffer strings.Builder
+		buffer.WriteString("{")
+		for i, cell := range row {
+			key := "column" + strconv.Itoa(i+1)
+			if i < len(header) && header[i] != "" {
+				key = header[i]
+			}
+			k, _ := json.Marshal(key)
+			v, _ := json.Marshal(cell)
+			if i > 0 {
+				buffer.WriteString(",")
+			}
+			buffer.Write(k)
+			buffer.WriteString(":")
+			buffer.Write(v)
+		}
+		buffer.WriteString("}\n")
+		if _, err := io.WriteString(w, buffer.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// xlsxFiles are static parts of a minimal XLSX file with a single worksheet
+var xlsxFiles = []struct {
+	name    string
+	content string
+}{
+	{"[Content_Types].xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`</Types>`},
+	{"_rels/.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`},
+	{"xl/workbook.xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<sheets><sheet name="Sheet1" sheetId="1" r:id="rId1"/></sheets></workbook>`},
+	{"xl/_rels/workbook.xml.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`</Relationships>`},
+}
+
+// xlsxColumn returns spreadsheet's column name (A, B, ..., Z, AA, ...) for 0-based index
+func xlsxColumn(i int) string {
+	name := ""
+	for i++; i > 0; i = (i - 1) / 26 {
+		name = string(rune('A'+(i-1)%26)) + name
+	}
+	return name
+}
+
+// isNumber tells if a cell is a plain decimal number, that can be stored as a number in a spreadsheet
+// numbers with leading zeros (like "007") are kept as text
+func isNumber(cell string) bool {
+	if strings.Trim(cell, "0123456789.-+eE") != "" {
+		return false
+	}
+	if digits := strings.TrimPrefix(cell, "-"); len(digits) > 1 && digits[0] == '0' && digits[1] != '.' {
+		return false
+	}
+	_, err := strconv.ParseFloat(cell, 64)
+	return err == nil
+}
+
+// xmlText removes control characters that aren't allowed in XML 1.0 documents
+func xmlText(cell string) string {
+	return strings.Map(func(r rune) rune {
+		if r < 0x20 && r != '\t' && r != '\n' && r != '\r' {
+			return -1
+		}
+		return r
+	}, cell)
+}
+
+// writeXLSXRow writes a single worksheet row, cells that are numbers are stored as numbers
+// and text cells that look like formulas are escaped
+func writeXLSXRow(w io.Writer, r int, cells []string) error {
+	var buffer strings.Builder
+	row := strconv.Itoa(r)
+	buffer.WriteString(`<row r="` + row + `">`)
+	for i, cell := range cells {
+		ref := xlsxColumn(i) + row
+		if isNumber(cell) {
+			buffer.WriteString(`<c r="` + ref + `"><v>` + cell + `</v></c>`)
+			continue
+		}
+		buffer.WriteString(`<c r="` + ref + `" t="inlineStr"><is><t xml:space="preserve">` + html.EscapeString(xmlText(formulaSafe(cell))) + `</t></is></c>`)
+	}
+	buffer.WriteString(`</row>`)
+	_, err := io.WriteString(w, buffer.String())
+	return err
+}
+
+// writeXLSX writes header and rows as a minimal XLSX file built with zip and XML from the standard library
+func writeXLSX(w io.Writer, header []string, rows [][]string) error {
+	archive := zip.NewWriter(w)
+	for _, f := range xlsxFiles {
+		file, err := archive.Create(f.name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(file, f.content); err != nil {
+			return err
+		}
+	}
+	sheet, err := archive.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(sheet, `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	if err != nil {
+		return err
+	}
+	if err := writeXLSXRow(sheet, 1, header); err != nil {
+		return err
+	}
+	for i, row := range rows {
+		if err := writeXLSXRow(sheet, i+2, row); err != nil {
+			return err
+		}
+	}
+	if _, err := io.WriteString(sheet, `</sheetData></worksheet>`); err != nil {
+		return err
+	}
+	return archive.Close()
+}
@@ -0,0 +1,190 @@
+package embgui
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func prepareExportPage() *EmbNode {
+	page := preparePage()
+	if page == nil {
+		return nil
+	}
+	tbody := page.GenTableBody([]string{"name", "disk", "action"})
+	row := tbody.Tr()
+	row.Td("john, \"jr\"")
+	row.Td("42")
+	row.Td("").MiniDelButton("Remove", "/users/1")
+	row = tbody.Tr()
+	row.Td("<anna>")
+	row.Td("007")
+	row.Td("")
+	return page
+}
+
+func TestExportLinks(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	v := page.ExportLinks(httptest.NewRequest("GET", "/users?page=2", nil)).render()
+	expectedResult := `<div class='buttons'><a class='button is-small' href='/users?export=csv&amp;page=2'>CSV</a>` +
+		`<a class='button is-small' href='/users?export=json&amp;page=2'>JSON</a>` +
+		`<a class='button is-small' href='/users?export=xlsx&amp;page=2'>XLSX</a></div>`
+	if v != expectedResult {
+		t.Error(
+			"For", "TestExportLinks",
+			"expected", expectedResult,
+			"got", v,
+		)
+	}
+}
+
+func TestWriteExport(t *testing.T) {
+	page := prepareExportPage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	tests := []struct {
+		url         string
+		contentType string
+		filename    string
+		body        string
+	}{
+		{"/users?export=csv", "text/csv; charset=utf-8", "users.csv",
+			"name,disk,action\n\"john, \"\"jr\"\"\",42,\n<anna>,007,\n"},
+		{"/users?export=json", "application/x-ndjson", "users.jsonl",
+			"{\"name\":\"john, \\\"jr\\\"\",\"disk\":\"42\",\"action\":\"\"}\n{\"name\":\"\\u003canna\\u003e\",\"disk\":\"007\",\"action\":\"\"}\n"},
+	}
+	for _, test := range tests {
+		w := httptest.NewRecorder()
+		ok, err := WriteExport(w, httptest.NewRequest("GET", test.url, nil), page, "users")
+		if !ok || err != nil {
+			t.Error("For", "TestWriteExport", test.url, "expected export, got", ok, err)
+		}
+		if w.Header().Get("Content-Type") != test.contentType ||
+			w.Header().Get("Content-Disposition") != `attachment; filename="`+test.filename+`"` ||
+			w.Body.String() != test.body {
+			t.Error(
+				"For", "TestWriteExport", test.url,
+				"expected", test.contentType, test.filename, test.body,
+				"got", w.Header(), w.Body.String(),
+			)
+		}
+	}
+	if ok, _ := WriteExport(httptest.NewRecorder(), httptest.NewRequest("GET", "/users", nil), page, "users"); ok {
+		t.Error("For", "TestWriteExport", "expected no export without export parameter")
+	}
+}
+
+func TestWriteCSVFormulas(t *testing.T) {
+	var w strings.Builder
+	rows := [][]string{{"=HYPERLINK(\"http://x\")", "+1", "-1.5", "@SUM(A1)", "\tx", "\rx", "-", "a=b"}}
+	if err := writeCSV(&w, []string{"=name"}, rows); err != nil {
+		t.Error("For", "TestWriteCSVFormulas", "Error:", err.Error())
+	}
+	expectedResult := "'=name\n\"'=HYPERLINK(\"\"http://x\"\")\",+1,-1.5,'@SUM(A1),'\tx,\"'\rx\",'-,a=b\n"
+	if w.String() != expectedResult {
+		t.Error("For", "TestWriteCSVFormulas", "expected", expectedResult, "got", w.String())
+	}
+	if rows[0][0] != "=HYPERLINK(\"http://x\")" {
+		t.Error("For", "TestWriteCSVFormulas", "expected rows to be left unchanged, got", rows[0][0])
+	}
+}
+
+func TestWriteXLSX(t *testing.T) {
+	page := prepareExportPage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	w := httptest.NewRecorder()
+	if ok, err := WriteExport(w, httptest.NewRequest("GET", "/users?export=xlsx", nil), page, "users"); !ok || err != nil {
+		t.Error("For", "TestWriteXLSX", "expected export, got", ok, err)
+	}
+	archive, err := zip.NewReader(bytes.NewReader(w.Body.Bytes()), int64(w.Body.Len()))
+	if err != nil {
+		t.Fatal("For", "TestWriteXLSX", "Error:", err.Error())
+	}
+	var sheet string
+	for _, f := range archive.File {
+		if f.Name == "xl/worksheets/sheet1.xml" {
+			r, _ := f.Open()
+			data, _ := io.ReadAll(r)
+			sheet = string(data)
+		}
+	}
+	testStrings := []string{`<c r="A1" t="inlineStr"><is><t xml:space="preserve">name</t></is></c>`,
+		`<c r="B2"><v>42</v></c>`,
+		`<c r="A3" t="inlineStr"><is><t xml:space="preserve">&lt;anna&gt;</t></is></c>`,
+		`<c r="B3" t="inlineStr"><is><t xml:space="preserve">007</t></is></c>`}
+	for _, str := range testStrings {
+		if strings.Contains(sheet, str) == false {
+			t.Error(
+				"For", "TestWriteXLSX",
+				"expected to have", str,
+				"got", sheet,
+			)
+		}
+	}
+	var row strings.Builder
+	if err := writeXLSXRow(&row, 1, []string{"a\x00b\x08\x0b\x0c\x0e\x1fc\td"}); err != nil {
+		t.Error("For", "TestWriteXLSX", "Error:", err.Error())
+	}
+	expectedRow := "<row r=\"1\"><c r=\"A1\" t=\"inlineStr\"><is><t xml:space=\"preserve\">abc\td</t></is></c></row>"
+	if row.String() != expectedRow {
+		t.Error("For", "TestWriteXLSX", "expected control characters to be removed", expectedRow, "got", row.String())
+	}
+	row.Reset()
+	if err := writeXLSXRow(&row, 1, []string{"=1+1", "-3"}); err != nil {
+		t.Error("For", "TestWriteXLSX", "Error:", err.Error())
+	}
+	if !strings.Contains(row.String(), `<t xml:space="preserve">&#39;=1+1</t>`) || !strings.Contains(row.String(), `<v>-3</v>`) {
+		t.Error("For", "TestWriteXLSX", "expected formula to be escaped, got", row.String())
+	}
+	if v := xlsxColumn(27); v != "AB" {
+		t.Error("For", "TestWriteXLSX", "expected column AB, got", v)
+	}
+}
+
+func TestWriteSourceExport(t *testing.T) {
+	source := &testSource{total: 45}
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("GET", "/users?export=csv&sort=name&page=2", nil)
+	if ok, err := WriteSourceExport(w, r, testColumns, source, "users"); !ok || err != nil {
+		t.Error("For", "TestWriteSourceExport", "expected export, got", ok, err)
+	}
+	if source.query.Limit != 45 || source.query.Offset != 0 || source.query.Sort != "name" {
+		t.Error("For", "TestWriteSourceExport", "expected all sorted rows, got", source.query)
+	}
+	if v := w.Body.String(); v != "Name,Surname,Email\njohn,smith\n" {
+		t.Error("For", "TestWriteSourceExport", "unexpected body", v)
+	}
+}
+
+func TestWriteExportSkipsCharts(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	page.LineChart("requests", Series{Name: "rps", Points: []Point{{1, 10}, {2, 20}}})
+	page.Sparkline([]float64{1, 2, 3})
+	tbody := page.GenTableBody([]string{"name"})
+	tbody.Tr().Td("john")
+	for _, node := range []*EmbNode{page, page.Children[len(page.Children)-1]} {
+		w := httptest.NewRecorder()
+		if _, err := WriteExport(w, httptest.NewRequest("GET", "/users?export=csv", nil), node, "users"); err != nil {
+			t.Error("For", "TestWriteExportSkipsCharts", "Error:", err.Error())
+		}
+		if w.Body.String() != "name\njohn\n" {
+			t.Error(
+				"For", "TestWriteExportSkipsCharts",
+				"expected", "name\njohn\n",
+				"got", w.Body.String(),
+			)
+		}
+	}
+}
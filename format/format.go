@@ -0,0 +1,325 @@
+// Package format turns numbers, sizes, durations and times into short human-friendly strings
+// it's used by embgui components (see EmbNode.TdValue() and Tile.Data), but it can be used on its own
+//
+//	format.Format(format.IECBytes(1536)) // "1.5 KiB"
+//	format.Format(90 * time.Second) // "1m 30s"
+//	format.Formatter{Locale: format.German}.Format(1234567) // "1.234.567"
+package format
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Locale describes decimal and thousands separators
+type Locale struct {
+	Decimal   string
+	Thousands string
+}
+
+// predefined locales
+var (
+	English = Locale{Decimal: ".", Thousands: ","}
+	German  = Locale{Decimal: ",", Thousands: "."}
+	French  = Locale{Decimal: ",", Thousands: "\u00a0"}
+	Polish  = Locale{Decimal: ",", Thousands: "\u00a0"}
+	Swiss   = Locale{Decimal: ".", Thousands: "'"}
+)
+
+// Bytes is a size in bytes, formatted with SI (1000-based) units like "1.5 kB"
+type Bytes int64
+
+// IECBytes is a size in bytes, formatted with IEC (1024-based) units like "1.5 KiB"
+type IECBytes int64
+
+// SI is a number formatted with SI suffix like "1.2k" or "3.4M"
+type SI float64
+
+// Percent is a percentage (0-100) formatted with a single decimal place like "12.5%"
+type Percent float64
+
+// Rate is a number of events per second formatted like "12.3/s"
+type Rate float64
+
+// Fixed is a number formatted with a fixed number of decimal places
+type Fixed struct {
+	Value     float64
+	Precision int
+}
+
+// String formats Bytes with default formatter
+func (b Bytes) String() string { return Default.Bytes(int64(b)) }
+
+// String formats IECBytes with default formatter
+func (b IECBytes) String() string { return Default.IECBytes(int64(b)) }
+
+// String formats SI with default formatter
+func (s SI) String() string { return Default.SI(float64(s)) }
+
+// String formats Percent with default formatter
+func (p Percent) String() string { return Default.Percent(float64(p), 1) }
+
+// String formats Rate with default formatter
+func (r Rate) String() string { return Default.Rate(float64(r)) }
+
+// String formats Fixed with default formatter
+func (f Fixed) String() string { return Default.Float(f.Value, f.Precision) }
+
+// Formatter formats values using its Locale (English if it's empty)
+// Now is used for relative times, time.Now is used if it's nil
+type Formatter struct {
+	Locale Locale
+	Now    func() time.Time
+}
+
+// Default is a formatter used by package-level Format()
+var Default = Formatter{}
+
+// Format formats any value with default formatter, see Formatter.Format()
+func Format(v interface{}) string {
+	return Default.Format(v)
+}
+
+// locale returns formatter's locale, English is used if it's empty
+func (f Formatter) locale() Locale {
+	if f.Locale.Decimal == "" {
+		return English
+	}
+	return f.Locale
+}
+
+// Format picks a format based on a type of a value:
+// Bytes, IECBytes, SI, Percent, Rate and Fixed use their own formats,
+// time.Duration is formatted like "1h 5m", time.Time relatively like "3 minutes ago",
+// integers get thousands separators, floats get two decimal places,
+// fmt.Stringer and other values are formatted with fmt
+func (f Formatter) Format(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case Bytes:
+		return f.Bytes(int64(v))
+	case IECBytes:
+		return f.IECBytes(int64(v))
+	case SI:
+		return f.SI(float64(v))
+	case Percent:
+		return f.Percent(float64(v), 1)
+	case Rate:
+		return f.Rate(float64(v))
+	case Fixed:
+		return f.Float(v.Value, v.Precision)
+	case time.Duration:
+		return f.Duration(v)
+	case time.Time:
+		return f.Relative(v)
+	case *time.Time:
+		if v == nil {
+			return ""
+		}
+		return f.Relative(*v)
+	case int:
+		return f.Int(int64(v))
+	case int8:
+		return f.Int(int64(v))
+	case int16:
+		return f.Int(int64(v))
+	case int32:
+		return f.Int(int64(v))
+	case int64:
+		return f.Int(v)
+	case uint:
+		return f.Int(int64(v))
+	case uint8:
+		return f.Int(int64(v))
+	case uint16:
+		return f.Int(int64(v))
+	case uint32:
+		return f.Int(int64(v))
+	case uint64:
+		if v > math.MaxInt64 {
+			return strconv.FormatUint(v, 10)
+		}
+		return f.Int(int64(v))
+	case float32:
+		return f.Float(float64(v), 2)
+	case float64:
+		return f.Float(v, 2)
+	case fmt.Stringer:
+		return v.String()
+	}
+	return fmt.Sprint(v)
+}
+
+// Int formats integer with thousands separators like "1,234,567"
+func (f Formatter) Int(v int64) string {
+	return f.group(strconv.FormatInt(v, 10))
+}
+
+// Float formats number with a fixed number of decimal places and thousands separators
+func (f Formatter) Float(v float64, precision int) string {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	if precision < 0 {
+		precision = 0
+	}
+	s := strconv.FormatFloat(v, 'f', precision, 64)
+	// -0.001 rounded to "-0.00" loses its sign
+	if strings.HasPrefix(s, "-") && strings.Trim(s, "-0.") == "" {
+		s = s[1:]
+	}
+	integer, fraction := s, ""
+	if i := strings.Index(s, "."); i >= 0 {
+		integer, fraction = s[:i], s[i+1:]
+	}
+	s = f.group(integer)
+	if fraction != "" {
+		s += f.locale().Decimal + fraction
+	}
+	return s
+}
+
+// group inserts thousands separators into a string of digits
+func (f Formatter) group(digits string) string {
+	sign := ""
+	if strings.HasPrefix(digits, "-") {
+		sign, digits = "-", digits[1:]
+	}
+	var buffer strings.Builder
+	for i, d := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			buffer.WriteString(f.locale().Thousands)
+		}
+		buffer.WriteRune(d)
+	}
+	return sign + buffer.String()
+}
+
+// short formats number with at most one decimal place, trailing zero is removed
+func (f Formatter) short(v float64) string {
+	s := f.Float(v, 1)
+	return strings.TrimSuffix(s, f.locale().Decimal+"0")
+}
+
+// scaled divides value by base until it's small enough and appends a unit
+func (f Formatter) scaled(v float64, base float64, units []string, space string) string {
+	i := 0
+	for math.Abs(v) >= base && i < len(units)-1 {
+		v /= base
+		i++
+	}
+	// 999.96 kB would be rounded to "1000 kB", so it's moved to the next unit
+	if math.Abs(v) >= base-0.05 && i < len(units)-1 {
+		v /= base
+		i++
+	}
+	return f.short(v) + space + units[i]
+}
+
+// Bytes formats size with SI (1000-based) units like "1.5 kB"
+func (f Formatter) Bytes(v int64) string {
+	return f.scaled(float64(v), 1000, []string{"B", "kB", "MB", "GB", "TB", "PB", "EB"}, " ")
+}
+
+// IECBytes formats size with IEC (1024-based) units like "1.5 KiB"
+func (f Formatter) IECBytes(v int64) string {
+	return f.scaled(float64(v), 1024, []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB", "EiB"}, " ")
+}
+
+// SI formats number with SI suffix like "1.2k" or "3.4M"
+func (f Formatter) SI(v float64) string {
+	return f.scaled(v, 1000, []string{"", "k", "M", "G", "T", "P", "E"}, "")
+}
+
+// Percent formats percentage (0-100) with a given number of decimal places like "12.5%"
+func (f Formatter) Percent(v float64, precision int) string {
+	return f.Float(v, precision) + "%"
+}
+
+// Rate formats number of events per second like "12.3/s", big rates get SI suffix like "1.2k/s"
+func (f Formatter) Rate(v float64) string {
+	return f.SI(v) + "/s"
+}
+
+// Duration formats duration with two most significant units like "1h 5m" or "350ms"
+func (f Formatter) Duration(d time.Duration) string {
+	sign := ""
+	if d < 0 {
+		sign, d = "-", -d
+	}
+	switch {
+	case d == 0:
+		return "0s"
+	case d < time.Microsecond:
+		return sign + strconv.FormatInt(int64(d), 10) + "ns"
+	case d < time.Millisecond:
+		return sign + f.short(float64(d)/float64(time.Microsecond)) + "µs"
+	case d < time.Second:
+		return sign + f.short(float64(d)/float64(time.Millisecond)) + "ms"
+	case d < time.Minute:
+		return sign + f.short(float64(d)/float64(time.Second)) + "s"
+	}
+	units := []struct {
+		length time.Duration
+		name   string
+	}{{24 * time.Hour, "d"}, {time.Hour, "h"}, {time.Minute, "m"}, {time.Second, "s"}}
+	for i, u := range units[:3] {
+		if d >= u.length {
+			s := sign + strconv.FormatInt(int64(d/u.length), 10) + u.name
+			next := units[i+1]
+			if rest := (d % u.length) / next.length; rest > 0 {
+				s += " " + strconv.FormatInt(int64(rest), 10) + next.name
+			}
+			return s
+		}
+	}
+	return sign + d.String()
+}
+
+// Relative formats time relatively to now, like "3 minutes ago" or "in 2 hours"
+func (f Formatter) Relative(t time.Time) string {
+	now := time.Now()
+	if f.Now != nil {
+		now = f.Now()
+	}
+	d := now.Sub(t)
+	future := d < 0
+	if future {
+		d = -d
+	}
+	if d < 10*time.Second {
+		return "just now"
+	}
+	units := []struct {
+		length time.Duration
+		name   string
+	}{
+		{365 * 24 * time.Hour, "year"},
+		{30 * 24 * time.Hour, "month"},
+		{7 * 24 * time.Hour, "week"},
+		{24 * time.Hour, "day"},
+		{time.Hour, "hour"},
+		{time.Minute, "minute"},
+		{time.Second, "second"},
+	}
+	for _, u := range units {
+		if d >= u.length {
+			count := int64(d / u.length)
+			s := strconv.FormatInt(count, 10) + " " + u.name
+			if count != 1 {
+				s += "s"
+			}
+			if future {
+				return "in " + s
+			}
+			return s + " ago"
+		}
+	}
+	return "just now"
+}
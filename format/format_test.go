@@ -0,0 +1,78 @@
+package format
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormat(t *testing.T) {
+	now := time.Date(2020, 3, 10, 12, 0, 0, 0, time.UTC)
+	f := Formatter{Now: func() time.Time { return now }}
+	tests := []struct {
+		value    interface{}
+		expected string
+	}{
+		{Bytes(512), "512 B"},
+		{Bytes(1500), "1.5 kB"},
+		{Bytes(999960), "1 MB"},
+		{IECBytes(1536), "1.5 KiB"},
+		{IECBytes(5 << 30), "5 GiB"},
+		{SI(1234), "1.2k"},
+		{SI(-3400000), "-3.4M"},
+		{SI(12), "12"},
+		{Percent(12.345), "12.3%"},
+		{Rate(12.34), "12.3/s"},
+		{Fixed{Value: 1234.5, Precision: 3}, "1,234.500"},
+		{Fixed{Value: -0.001, Precision: 2}, "0.00"},
+		{1234567, "1,234,567"},
+		{int64(-1234), "-1,234"},
+		{3.14159, "3.14"},
+		{350 * time.Millisecond, "350ms"},
+		{1500 * time.Millisecond, "1.5s"},
+		{90 * time.Second, "1m 30s"},
+		{3*time.Hour + 5*time.Minute + 7*time.Second, "3h 5m"},
+		{50 * time.Hour, "2d 2h"},
+		{now.Add(-3 * time.Minute), "3 minutes ago"},
+		{now.Add(-time.Hour), "1 hour ago"},
+		{now.Add(2 * 24 * time.Hour), "in 2 days"},
+		{now.Add(-5 * time.Second), "just now"},
+		{"text", "text"},
+		{nil, ""},
+	}
+	for _, test := range tests {
+		v := f.Format(test.value)
+		if v != test.expected {
+			t.Error(
+				"For", "TestFormat", test.value,
+				"expected", test.expected,
+				"got", v,
+			)
+		}
+	}
+}
+
+func TestLocale(t *testing.T) {
+	f := Formatter{Locale: German}
+	tests := []struct {
+		value    interface{}
+		expected string
+	}{
+		{1234567, "1.234.567"},
+		{1234.5, "1.234,50"},
+		{Bytes(1500), "1,5 kB"},
+		{Percent(99.5), "99,5%"},
+	}
+	for _, test := range tests {
+		v := f.Format(test.value)
+		if v != test.expected {
+			t.Error(
+				"For", "TestLocale", test.value,
+				"expected", test.expected,
+				"got", v,
+			)
+		}
+	}
+	if v := (Formatter{Locale: Polish}).Int(1000); v != "1\u00a0000" {
+		t.Error("For", "TestLocale", "expected non-breaking space, got", v)
+	}
+}
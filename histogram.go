@@ -0,0 +1,261 @@
+package embgui
+
+import (
+	"math"
+	"strconv"
+	"time"
+)
+
+// HistogramOptions customizes Histogram
+// Edges are fixed bin boundaries, values outside of them are skipped, as well as NaN and infinite values
+// infinite edges are dropped, so are edges <= 0 with LogScale
+// if Edges are empty, Bins automatic bins are computed (Sturges' rule is used if Bins is 0)
+// LogScale uses logarithmic value axis with exponentially growing bins, values <= 0 are skipped
+type HistogramOptions struct {
+	Bins     int
+	Edges    []float64
+	LogScale bool
+}
+
+// HeatmapColumn is a single column of a heatmap, Counts are matched with buckets by index
+// see Heatmap()
+type HeatmapColumn struct {
+	Time   time.Time
+	Counts []float64
+}
+
+// HeatmapOptions customizes Heatmap
+// Cumulative means Counts are Prometheus-style "le" buckets, each including all smaller buckets
+// Location is used to format time labels, UTC is used if it's nil
+// Color is a bulma's color or hex value used for the highest count, is-link is used by default
+type HeatmapOptions struct {
+	Cumulative bool
+	Location   *time.Location
+	Color      string
+}
+
+// histogramEdges computes bin boundaries for values
+func histogramEdges(values []float64, opts HistogramOptions) []float64 {
+	// edges that can't be drawn are dropped, automatic bins are used if less than two are left
+	var edges []float64
+	for _, e := range opts.Edges {
+		if finite(e) && (!opts.LogScale || e > 0) {
+			edges = append(edges, e)
+		}
+	}
+	if len(edges) > 1 {
+		return edges
+	}
+	bins := opts.Bins
+	if bins <= 0 && len(values) == 0 {
+		bins = 1
+	} else if bins <= 0 {
+		bins = int(math.Ceil(math.Log2(float64(len(values))))) + 1
+	}
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, v := range values {
+		if !finite(v) || opts.LogScale && v <= 0 {
+			continue
+		}
+		lo, hi = minFloat(lo, v), maxFloat(hi, v)
+	}
+	if math.IsInf(lo, 0) {
+		lo, hi = 1, 10
+	}
+	if !opts.LogScale {
+		return niceTicks(lo, hi, bins+1)
+	}
+	lo = math.Pow(10, math.Floor(math.Log10(lo)))
+	hi = math.Pow(10, math.Ceil(math.Log10(hi)))
+	if hi <= lo {
+		hi = lo * 10
+	}
+	edges = make([]float64, bins+1)
+	for i := range edges {
+		edges[i], _ = strconv.ParseFloat(strconv.FormatFloat(lo*math.Pow(hi/lo, float64(i)/float64(bins)), 'g', 3, 64), 64)
+	}
+	return edges
+}
+
+// histogramCounts counts values in bins, the last bin includes its upper edge
+// NaN and infinite values are skipped
+func histogramCounts(values []float64, edges []float64) []float64 {
+	counts := make([]float64, len(edges)-1)
+	for _, v := range values {
+		if !finite(v) {
+			continue
+		}
+		for i := range counts {
+			if v >= edges[i] && (v < edges[i+1] || (i == len(counts)-1 && v == edges[i+1])) {
+				counts[i]++
+				break
+			}
+		}
+	}
+	return counts
+}
+
+// Histogram generates server-side rendered SVG histogram of values
+// it's handy for latency distributions, use LogScale for long tails
+// a table of bins is attached for text-based browsers
+//
+//		page.Histogram("latency [ms]", latencies, embgui.HistogramOptions{LogScale: true})
+func (n *EmbNode) Histogram(title string, values []float64, opts HistogramOptions) *EmbNode {
+	edges := histogramEdges(values, opts)
+	counts := histogramCounts(values, edges)
+	maxCount := 4.0
+	for _, c := range counts {
+		maxCount = maxFloat(maxCount, c)
+	}
+	// scale maps a value to the horizontal axis
+	scale := func(v float64) float64 {
+		if opts.LogScale {
+			return math.Log10(v)
+		}
+		return v
+	}
+	yTicks := niceTicks(0, maxCount, 5)
+	yLabels := tickLabels(yTicks)
+	p := newXYPlot(title, false, yLabels)
+	p.xMin, p.xMax = scale(edges[0]), scale(edges[len(edges)-1])
+	p.yMin, p.yMax = yTicks[0], yTicks[len(yTicks)-1]
+
+	s := newSVG(chartWidth, chartHeight, title)
+	drawTitle(s, title)
+	p.drawYAxis(s, yTicks, yLabels)
+	for i, c := range counts {
+		x1, x2 := p.x(scale(edges[i])), p.x(scale(edges[i+1]))
+		tooltip := fmtValue(edges[i]) + " - " + fmtValue(edges[i+1]) + ": " + fmtValue(c)
+		s.open("rect", "x", fmtFloat(x1), "y", fmtFloat(p.y(c)), "width", fmtFloat(x2-x1), "height", fmtFloat(p.bottom-p.y(c)),
+			"fill", palette[0], "stroke", "#ffffff")
+		s.tag("title", tooltip)
+		s.end("rect")
+	}
+	var xTicks []float64
+	var xLabels []string
+	switch {
+	case opts.LogScale && finite(p.xMin) && finite(p.xMax):
+		for e := math.Floor(p.xMin); e <= p.xMax; e++ {
+			if e >= p.xMin {
+				xTicks, xLabels = append(xTicks, e), append(xLabels, fmtValue(math.Pow(10, e)))
+			}
+		}
+	case len(edges) <= 12:
+		xTicks, xLabels = edges, tickLabels(edges)
+	default:
+		for _, t := range niceTicks(edges[0], edges[len(edges)-1], 8) {
+			if t >= edges[0] && t <= edges[len(edges)-1] {
+				xTicks = append(xTicks, t)
+			}
+		}
+		xLabels = tickLabels(xTicks)
+	}
+	p.drawXAxis(s, xTicks, xLabels, false)
+
+	figure := n.chart(s.String())
+	table := figure.textFallback().GenTableBody([]string{"from", "to", "count"})
+	for i, c := range counts {
+		row := table.Tr()
+		row.Td(fmtValue(edges[i]))
+		row.Td(fmtValue(edges[i+1]))
+		row.Td(fmtValue(c))
+	}
+	return figure
+}
+
+// bucketLabel formats upper bound of a heatmap bucket
+func bucketLabel(le float64) string {
+	if math.IsInf(le, 1) {
+		return "+Inf"
+	}
+	return "≤" + fmtValue(le)
+}
+
+// Heatmap generates server-side rendered SVG heatmap of a time × bucket grid
+// buckets are upper bounds of value ranges (like Prometheus "le" labels, +Inf is allowed)
+// columns are drawn in the given order with the same width, so they should have a regular interval
+// a color scale legend is drawn below and a table is attached for text-based browsers
+//
+//		page.Heatmap("latency", []float64{0.1, 0.5, 1, math.Inf(1)}, columns, embgui.HeatmapOptions{Cumulative: true})
+func (n *EmbNode) Heatmap(title string, buckets []float64, columns []HeatmapColumn, opts HeatmapOptions) *EmbNode {
+	loc := opts.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	color := colorValue(opts.Color, bulmaColors["is-link"])
+	grid := make([][]float64, len(columns))
+	maxCount := 0.0
+	for i, col := range columns {
+		grid[i] = make([]float64, len(buckets))
+		prev := 0.0
+		for j := range buckets {
+			if j >= len(col.Counts) {
+				break
+			}
+			c := col.Counts[j]
+			if opts.Cumulative {
+				// counter resets may make cumulative buckets decrease, they're clamped to 0
+				c, prev = maxFloat(col.Counts[j]-prev, 0), col.Counts[j]
+			}
+			grid[i][j] = c
+			maxCount = maxFloat(maxCount, c)
+		}
+	}
+	labels := make([]string, len(buckets))
+	for i, le := range buckets {
+		labels[i] = bucketLabel(le)
+	}
+	p := newXYPlot(title, true, labels)
+	s := newSVG(chartWidth, chartHeight, title)
+	drawTitle(s, title)
+	cellW, cellH := p.right-p.left, p.bottom-p.top
+	if len(columns) > 0 {
+		cellW /= float64(len(columns))
+	}
+	if len(buckets) > 0 {
+		cellH /= float64(len(buckets))
+	}
+	for j, l := range labels {
+		s.text(p.left-6, p.bottom-cellH*(float64(j)+0.5)+4, "end", axisColor, l)
+	}
+	for i, col := range columns {
+		for j, c := range grid[i] {
+			ratio := 0.0
+			if maxCount > 0 {
+				ratio = c / maxCount
+			}
+			tooltip := col.Time.In(loc).Format("2006-01-02 15:04:05") + " " + labels[j] + ": " + fmtValue(c)
+			s.rect(p.left+cellW*float64(i), p.bottom-cellH*float64(j+1), cellW, cellH, mixColor(color, ratio), tooltip)
+		}
+	}
+	s.line(p.left, p.top, p.left, p.bottom, axisColor)
+	s.line(p.left, p.bottom, p.right, p.bottom, axisColor)
+	if len(columns) > 0 {
+		_, layout := timeTicks(columns[0].Time, columns[len(columns)-1].Time, 6, loc)
+		every := (len(columns) + 5) / 6
+		for i := 0; i < len(columns); i += every {
+			x := p.left + cellW*(float64(i)+0.5)
+			s.line(x, p.bottom, x, p.bottom+4, axisColor)
+			s.text(x, p.bottom+16, "middle", axisColor, columns[i].Time.In(loc).Format(layout))
+		}
+	}
+	// color scale legend
+	x := 20.0
+	y := float64(chartHeight - 18)
+	s.text(x, y+9, "start", axisColor, "0")
+	for i := 0; i <= 4; i++ {
+		s.rect(x+12+float64(i)*16, y, 16, 10, mixColor(color, float64(i)/4), "")
+	}
+	s.text(x+98, y+9, "start", axisColor, fmtValue(maxCount))
+
+	figure := n.chart(s.String())
+	table := figure.textFallback().GenTableBody(append([]string{"time"}, labels...))
+	for i, col := range columns {
+		row := table.Tr()
+		row.Td(col.Time.In(loc).Format("2006-01-02 15:04:05 MST"))
+		for _, c := range grid[i] {
+			row.Td(fmtValue(c))
+		}
+	}
+	return figure
+}
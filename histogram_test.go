@@ -0,0 +1,88 @@
+package embgui
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHistogramBins(t *testing.T) {
+	values := []float64{1, 2, 2, 3, 7, 9, 10, 35}
+	tests := []struct {
+		values []float64
+		opts   HistogramOptions
+		edges  []float64
+		counts []float64
+	}{
+		{values, HistogramOptions{Edges: []float64{0, 5, 10}}, []float64{0, 5, 10}, []float64{4, 3}},
+		{values, HistogramOptions{Bins: 4}, []float64{0, 10, 20, 30, 40}, []float64{6, 1, 0, 1}},
+		{values, HistogramOptions{Bins: 4, LogScale: true}, []float64{1, 3.16, 10, 31.6, 100}, []float64{4, 2, 1, 1}},
+		{nil, HistogramOptions{}, []float64{0, 10}, []float64{0}},
+		{nil, HistogramOptions{LogScale: true}, []float64{1, 10}, []float64{0}},
+		{values, HistogramOptions{Edges: []float64{0, 1, 10, 100}, LogScale: true}, []float64{1, 10, 100}, []float64{6, 2}},
+		{values, HistogramOptions{Edges: []float64{-1, 0}, LogScale: true}, []float64{1, 3.16, 10, 31.6, 100}, []float64{4, 2, 1, 1}},
+	}
+	for _, test := range tests {
+		// empty sample sets are rendered too, e.g. before the first request
+		preparePage().Histogram("empty", test.values, test.opts)
+		edges := histogramEdges(test.values, test.opts)
+		counts := histogramCounts(test.values, edges)
+		if !reflect.DeepEqual(edges, test.edges) || !reflect.DeepEqual(counts, test.counts) {
+			t.Error(
+				"For", "TestHistogramBins", test.opts,
+				"expected", test.edges, test.counts,
+				"got", edges, counts,
+			)
+		}
+	}
+}
+
+func TestHistogram(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	v := page.Histogram("latency", []float64{1, 2, 2, 3, 7}, HistogramOptions{Edges: []float64{0, 5, 10}}).render()
+	testStrings := []string{`<title>0 - 5: 4</title>`,
+		`<title>5 - 10: 1</title>`,
+		`<tr><td>0</td><td>5</td><td>4</td></tr>`}
+	for _, str := range testStrings {
+		if strings.Contains(v, str) == false {
+			t.Error(
+				"For", "TestHistogram",
+				"expected to have", str,
+				"got", v,
+			)
+		}
+	}
+}
+
+func TestHeatmap(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	base := time.Date(2020, 3, 10, 12, 0, 0, 0, time.UTC)
+	columns := []HeatmapColumn{
+		{Time: base, Counts: []float64{2, 5, 6}},
+		{Time: base.Add(time.Minute), Counts: []float64{8, 8, 8}},
+	}
+	v := page.Heatmap("latency", []float64{0.1, 1, math.Inf(1)}, columns, HeatmapOptions{Cumulative: true}).render()
+	testStrings := []string{`<th>time</th><th>≤0.1</th><th>≤1</th><th>+Inf</th>`,
+		`<tr><td>2020-03-10 12:00:00 UTC</td><td>2</td><td>3</td><td>1</td></tr>`,
+		`<tr><td>2020-03-10 12:01:00 UTC</td><td>8</td><td>0</td><td>0</td></tr>`,
+		`fill='#3273dc'><title>2020-03-10 12:01:00 ≤0.1: 8</title>`,
+		`fill='#ffffff'><title>2020-03-10 12:01:00 +Inf: 0</title>`,
+		`>12:00:00</text>`}
+	for _, str := range testStrings {
+		if strings.Contains(v, str) == false {
+			t.Error(
+				"For", "TestHeatmap",
+				"expected to have", str,
+				"got", v,
+			)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package embgui
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/inteliwise/embgui/format"
+)
+
+// maxKeyValueDepth stops KeyValue() from following cyclic pointers forever
+const maxKeyValueDepth = 10
+
+// isNested tells if a value is shown as a sub-list: a struct, a map, or a slice
+// time.Time, fmt.Stringer and []byte are shown as a single value
+func isNested(v reflect.Value) bool {
+	if !v.IsValid() || v.Type() == timeType || v.Type().Implements(stringerType) {
+		return false
+	}
+	switch v.Kind() {
+	case reflect.Struct, reflect.Map:
+		return true
+	case reflect.Slice, reflect.Array:
+		return v.Type().Elem().Kind() != reflect.Uint8
+	}
+	return false
+}
+
+// indirect dereferences pointers and interfaces, it returns invalid value for nil
+// pointers implementing fmt.Stringer are kept
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		if v.Kind() == reflect.Ptr && v.Type().Implements(stringerType) {
+			return v
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// keyLess orders map keys, numbers are compared as numbers and other keys as text
+func keyLess(a reflect.Value, b reflect.Value) bool {
+	x, xOk := numericValue(a.Interface())
+	y, yOk := numericValue(b.Interface())
+	if xOk && yOk {
+		return x < y
+	}
+	return fmt.Sprint(a.Interface()) < fmt.Sprint(b.Interface())
+}
+
+// KeyValue generates a description list of a struct, a map or a slice
+// map keys are sorted (numerically if they're numbers), struct fields use the same tags as TableFromSlice(): `embgui:"Label,fmt=bytes,hide,secret"`
+// nested structs, maps and slices are shown as indented sub-lists
+//
+//		type Config struct {
+//			Addr     string `embgui:"Listen address"`
+//			MaxBody  int64  `embgui:"Max body,fmt=iec"`
+//			Password string `embgui:"Password,secret"`
+//			Limits   map[string]int
+//		}
+//		page.KeyValue(config)
+func (n *EmbNode) KeyValue(data interface{}) *EmbNode {
+	list := n.add(&EmbNode{HTMLTag: "dl", Class: "embgui-kv"})
+	list.keyValues(n.formatter(), reflect.ValueOf(data), 0)
+	return list
+}
+
+// keyValue adds a single dt/dd pair, nested values are added as a sub-list inside dd
+func (n *EmbNode) keyValue(f format.Formatter, key string, v reflect.Value, spec string, depth int) {
+	n.add(&EmbNode{HTMLTag: "dt", Text: key})
+	dd := n.add(&EmbNode{HTMLTag: "dd"})
+	v = indirect(v)
+	if !v.IsValid() {
+		return
+	}
+	if !isNested(v) {
+		dd.Text = formatCell(f, v.Interface(), spec)
+		return
+	}
+	if depth >= maxKeyValueDepth {
+		dd.Text = "…"
+		return
+	}
+	dd.add(&EmbNode{HTMLTag: "dl", Class: "embgui-kv"}).keyValues(f, v, depth+1)
+}
+
+// keyValues adds pairs of a struct, a map or a slice to a description list
+func (n *EmbNode) keyValues(f format.Formatter, v reflect.Value, depth int) {
+	v = indirect(v)
+	if !v.IsValid() {
+		return
+	}
+	switch {
+	case !isNested(v):
+		n.add(&EmbNode{HTMLTag: "dd", Text: formatCell(f, v.Interface(), "")})
+	case v.Kind() == reflect.Map:
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return keyLess(keys[i], keys[j]) })
+		for _, k := range keys {
+			n.keyValue(f, fmt.Sprint(k.Interface()), v.MapIndex(k), "", depth)
+		}
+	case v.Kind() == reflect.Struct:
+		n.structValues(f, v, depth)
+	default:
+		for i := 0; i < v.Len(); i++ {
+			n.keyValue(f, strconv.Itoa(i), v.Index(i), "", depth)
+		}
+	}
+}
+
+// structValues adds pairs of struct's exported fields, embedded structs without a tag are inlined
+func (n *EmbNode) structValues(f format.Formatter, v reflect.Value, depth int) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("embgui")
+		if tag == "-" || (field.PkgPath != "" && !field.Anonymous) {
+			continue
+		}
+		ft := parseTableTag(tag)
+		if ft.hidden {
+			continue
+		}
+		value := v.Field(i)
+		if field.Anonymous && tag == "" {
+			if embedded := indirect(value); embedded.IsValid() && embedded.Kind() == reflect.Struct {
+				n.structValues(f, embedded, depth)
+			}
+			continue
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+		name := ft.name
+		if name == "" {
+			name = field.Name
+		}
+		if ft.secret {
+			n.add(&EmbNode{HTMLTag: "dt", Text: name})
+			n.add(&EmbNode{HTMLTag: "dd", Text: redact(value.Interface())})
+			continue
+		}
+		n.keyValue(f, name, value, ft.format, depth)
+	}
+}
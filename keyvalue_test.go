@@ -0,0 +1,60 @@
+package embgui
+
+import (
+	"testing"
+)
+
+type testLimits struct {
+	Body int64 `embgui:"Max body,fmt=iec"`
+}
+
+type testConfig struct {
+	Addr     string `embgui:"Listen address"`
+	Password string `embgui:"Password,secret"`
+	Token    string `embgui:"Token,secret"`
+	Debug    bool   `embgui:"-"`
+	Limits   *testLimits
+	Backends []string
+	Weights  map[int]string
+	internal string
+}
+
+func TestKeyValue(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	page.KeyValue(testConfig{Addr: ":8080", Password: "hunter2", Debug: true, Limits: &testLimits{Body: 2048},
+		Backends: []string{"a", "b"}, Weights: map[int]string{10: "x", 2: "y"}, internal: "z"})
+	v := page.render()
+	expectedResult := `<><dl class='embgui-kv'><dt>Listen address</dt><dd>:8080</dd>` +
+		`<dt>Password</dt><dd>********</dd><dt>Token</dt><dd></dd>` +
+		`<dt>Limits</dt><dd><dl class='embgui-kv'><dt>Max body</dt><dd>2 KiB</dd></dl></dd>` +
+		`<dt>Backends</dt><dd><dl class='embgui-kv'><dt>0</dt><dd>a</dd><dt>1</dt><dd>b</dd></dl></dd>` +
+		`<dt>Weights</dt><dd><dl class='embgui-kv'><dt>2</dt><dd>y</dd><dt>10</dt><dd>x</dd></dl></dd></dl></>`
+	if v != expectedResult {
+		t.Error(
+			"For", "TestKeyValue",
+			"expected", expectedResult,
+			"got", v,
+		)
+	}
+}
+
+func TestKeyValueMap(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	var missing *testLimits
+	page.KeyValue(map[string]interface{}{"b": 1500, "a": nil, "c": missing})
+	v := page.render()
+	expectedResult := `<><dl class='embgui-kv'><dt>a</dt><dd></dd><dt>b</dt><dd>1,500</dd><dt>c</dt><dd></dd></dl></>`
+	if v != expectedResult {
+		t.Error(
+			"For", "TestKeyValueMap",
+			"expected", expectedResult,
+			"got", v,
+		)
+	}
+}
@@ -0,0 +1,34 @@
+package embgui
+
+// Level generates bulma's level, a horizontal bar for toolbars and compact stat strips
+// it returns left, centered and right slots, use LevelItem() or LevelStat() to fill them
+// items are stacked vertically on mobile
+//
+//		left, _, right := page.Level()
+//		left.LevelItem().SearchForm("/users", "")
+//		right.LevelItem().LinkButton("Add user", "/users/new")
+//
+//		_, center, _ := page.Level()
+//		center.LevelStat("requests", "3,456")
+//		center.LevelStat("errors", "12")
+func (n *EmbNode) Level() (*EmbNode, *EmbNode, *EmbNode) {
+	level := n.add(&EmbNode{HTMLTag: "nav", Class: "level"})
+	left := level.add(&EmbNode{HTMLTag: "div", Class: "level-left"})
+	// centered items have to be direct children of the level, so the slot doesn't generate a box
+	center := level.add(&EmbNode{HTMLTag: "div", Style: "display: contents"})
+	right := level.add(&EmbNode{HTMLTag: "div", Class: "level-right"})
+	return left, center, right
+}
+
+// LevelItem generates a single item of a level's slot
+func (n *EmbNode) LevelItem() *EmbNode {
+	return n.add(&EmbNode{HTMLTag: "div", Class: "level-item"})
+}
+
+// LevelStat generates centered level item with a small heading and a value below it
+func (n *EmbNode) LevelStat(heading string, value string) *EmbNode {
+	item := n.add(&EmbNode{HTMLTag: "div", Class: "level-item has-text-centered"}).add(&EmbNode{HTMLTag: "div"})
+	item.add(&EmbNode{HTMLTag: "p", Class: "heading", Text: heading})
+	item.add(&EmbNode{HTMLTag: "p", Class: "title", Text: value})
+	return item
+}
@@ -0,0 +1,28 @@
+package embgui
+
+import (
+	"testing"
+)
+
+func TestLevel(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	left, center, right := page.Level()
+	left.LevelItem().P("12 users")
+	center.LevelStat("errors", "12")
+	right.LevelItem().LinkButton("Add user", "/users/new")
+	v := page.render()
+	expectedResult := `<><nav class='level'><div class='level-left'><div class='level-item'><p>12 users</p></div></div>` +
+		`<div style='display: contents'><div class='level-item has-text-centered'><div>` +
+		`<p class='heading'>errors</p><p class='title'>12</p></div></div></div>` +
+		`<div class='level-right'><div class='level-item'><a class='button is-link' href='/users/new' style='margin: .25rem'>Add user</a></div></div></nav></>`
+	if v != expectedResult {
+		t.Error(
+			"For", "TestLevel",
+			"expected", expectedResult,
+			"got", v,
+		)
+	}
+}
@@ -0,0 +1,152 @@
+package embgui
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LogEntry is a single structured log line
+// Level is any of debug, info, warn (warning), error, fatal (case doesn't matter)
+// see LogView()
+type LogEntry struct {
+	Time    time.Time
+	Level   string
+	Message string
+	Fields  map[string]interface{}
+}
+
+// LogOptions customizes LogView
+// Location is used to format times, UTC is used if it's nil
+// entries are sorted by time, the oldest first unless NewestFirst is set
+type LogOptions struct {
+	Location    *time.Location
+	NewestFirst bool
+}
+
+// logLevels are known levels from the least to the most severe, with colors of their tags
+var logLevels = []struct {
+	name  string
+	color string
+}{
+	{"debug", "is-light"},
+	{"info", "is-info"},
+	{"warn", "is-warning"},
+	{"error", "is-danger"},
+	{"fatal", "is-black"},
+}
+
+// logLevel returns severity of a level, unknown levels are treated as info
+func logLevel(level string) int {
+	level = strings.ToLower(level)
+	switch level {
+	case "warning":
+		level = "warn"
+	case "err":
+		level = "error"
+	case "panic", "critical":
+		level = "fatal"
+	}
+	for i, l := range logLevels {
+		if l.name == level {
+			return i
+		}
+	}
+	return 1
+}
+
+// logFields formats fields as sorted key=value pairs, values with spaces or quotes are quoted (like logfmt)
+func logFields(fields map[string]interface{}) string {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, len(keys))
+	for i, key := range keys {
+		value := fmt.Sprint(fields[key])
+		if value == "" || strings.ContainsAny(value, " \t\n\"=") {
+			value = strconv.Quote(value)
+		}
+		pairs[i] = key + "=" + value
+	}
+	return strings.Join(pairs, " ")
+}
+
+// LogView generates a log viewer, entries are colored by level and long lines are wrapped
+// entries can be filtered with ?level= (minimal level) and ?search= (text in a message or fields) query parameters,
+// level buttons and a search form that set them are generated above the log
+// use page's Refresh() to tail the log without JavaScript
+//
+//		page.Refresh(10)
+//		page.LogView(r, entries, embgui.LogOptions{NewestFirst: true})
+func (n *EmbNode) LogView(r *http.Request, entries []LogEntry, opts LogOptions) *EmbNode {
+	loc := opts.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	level := queryParam(r, "level")
+	search := queryParam(r, "search")
+	minLevel := 0
+	if level != "" {
+		minLevel = logLevel(level)
+	}
+
+	left, _, right := n.Level()
+	levels := left.LevelItem().add(&EmbNode{HTMLTag: "div", Class: "buttons has-addons"})
+	// levelButton adds a link that sets the level filter, the active one is selected
+	levelButton := func(text string, value string, active bool) {
+		class := "button is-small"
+		if active {
+			class += " is-link is-selected"
+		}
+		levels.add(&EmbNode{HTMLTag: "a", Class: class, Href: queryLink(r, "level", value), Text: text})
+	}
+	levelButton("all", "", level == "")
+	for i, l := range logLevels {
+		levelButton(l.name, l.name, level != "" && i == minLevel)
+	}
+	form := right.LevelItem().add(&EmbNode{HTMLTag: "form", Method: "GET"})
+	form.keepQuery(r, map[string]bool{"search": true})
+	field := form.add(&EmbNode{HTMLTag: "div", Class: "field has-addons"})
+	field.add(&EmbNode{HTMLTag: "div", Class: "control"}).
+		add(&EmbNode{HTMLTag: "input", Type: "text", Class: "input is-small", Name: "search", Value: search, Placeholder: "search"})
+	field.add(&EmbNode{HTMLTag: "div", Class: "control"}).
+		add(&EmbNode{HTMLTag: "button", Type: "submit", Class: "button is-small is-info", Text: "Filter"})
+
+	var shown []LogEntry
+	for _, e := range entries {
+		fields := logFields(e.Fields)
+		if logLevel(e.Level) < minLevel {
+			continue
+		}
+		if search != "" && !strings.Contains(strings.ToLower(e.Message+" "+fields), strings.ToLower(search)) {
+			continue
+		}
+		shown = append(shown, e)
+	}
+	sort.SliceStable(shown, func(i, j int) bool {
+		if opts.NewestFirst {
+			return shown[i].Time.After(shown[j].Time)
+		}
+		return shown[i].Time.Before(shown[j].Time)
+	})
+
+	log := n.add(&EmbNode{HTMLTag: "div", Class: "embgui-log"})
+	if len(shown) == 0 {
+		log.add(&EmbNode{HTMLTag: "p", Class: "has-text-grey", Text: "no log entries"})
+	}
+	for _, e := range shown {
+		line := log.add(&EmbNode{HTMLTag: "div", Class: "embgui-log-entry"})
+		line.add(&EmbNode{HTMLTag: "span", Class: "has-text-grey", Text: e.Time.In(loc).Format("2006-01-02 15:04:05.000")})
+		line.Tag(strings.ToUpper(e.Level), logLevels[logLevel(e.Level)].color)
+		line.add(&EmbNode{HTMLTag: "span", Text: e.Message})
+		if fields := logFields(e.Fields); fields != "" {
+			line.add(&EmbNode{HTMLTag: "span", Class: "has-text-grey", Text: fields})
+		}
+	}
+	return log
+}
@@ -0,0 +1,87 @@
+package embgui
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testLogEntries = []LogEntry{
+	{Time: time.Date(2020, 3, 10, 12, 0, 1, 0, time.UTC), Level: "INFO", Message: "started", Fields: map[string]interface{}{"port": 8080}},
+	{Time: time.Date(2020, 3, 10, 12, 0, 0, 0, time.UTC), Level: "debug", Message: "config loaded"},
+	{Time: time.Date(2020, 3, 10, 12, 0, 2, 0, time.UTC), Level: "warning", Message: "slow query",
+		Fields: map[string]interface{}{"sql": "SELECT 1", "ms": 1500}},
+	{Time: time.Date(2020, 3, 10, 12, 0, 3, 0, time.UTC), Level: "error", Message: "connection lost"},
+}
+
+func TestLogView(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	r := httptest.NewRequest("GET", "/logs?level=warn&tab=app", nil)
+	log := page.LogView(r, testLogEntries, LogOptions{NewestFirst: true})
+	v := log.render()
+	expectedResult := `<div class='embgui-log'>` +
+		`<div class='embgui-log-entry'><span class='has-text-grey'>2020-03-10 12:00:03.000</span>` +
+		`<span class='tag is-danger'>ERROR</span><span>connection lost</span></div>` +
+		`<div class='embgui-log-entry'><span class='has-text-grey'>2020-03-10 12:00:02.000</span>` +
+		`<span class='tag is-warning'>WARNING</span><span>slow query</span>` +
+		`<span class='has-text-grey'>ms=1500 sql=&#34;SELECT 1&#34;</span></div></div>`
+	if v != expectedResult {
+		t.Error(
+			"For", "TestLogView",
+			"expected", expectedResult,
+			"got", v,
+		)
+	}
+	v = page.render()
+	for _, expected := range []string{
+		`<a class='button is-small' href='/logs?tab=app'>all</a>`,
+		`<a class='button is-small is-link is-selected' href='/logs?level=warn&amp;tab=app'>warn</a>`,
+		`<form action='/logs' method='GET'><input type='hidden' name='level' value='warn'></input>` +
+			`<input type='hidden' name='tab' value='app'></input>`,
+	} {
+		if !strings.Contains(v, expected) {
+			t.Error(
+				"For", "TestLogView",
+				"expected", expected,
+				"got", v,
+			)
+		}
+	}
+}
+
+func TestLogViewSearch(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	r := httptest.NewRequest("GET", "/logs?search=PORT", nil)
+	v := page.LogView(r, testLogEntries, LogOptions{}).render()
+	if !strings.Contains(v, "started") || strings.Count(v, "embgui-log-entry") != 1 {
+		t.Error("For", "TestLogViewSearch", "expected a single entry, got", v)
+	}
+	r = httptest.NewRequest("GET", "/logs?search=nothing", nil)
+	v = page.LogView(r, testLogEntries, LogOptions{}).render()
+	if v != `<div class='embgui-log'><p class='has-text-grey'>no log entries</p></div>` {
+		t.Error("For", "TestLogViewSearch", "expected no entries, got", v)
+	}
+}
+
+func TestRefresh(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	v, _ := page.RenderPage()
+	if strings.Contains(v, "http-equiv") {
+		t.Error("For", "TestRefresh", "expected no refresh by default")
+	}
+	page.Refresh(10)
+	v, _ = page.RenderPage()
+	if !strings.Contains(v, `<meta http-equiv="refresh" content="10">`) {
+		t.Error("For", "TestRefresh", "expected refresh meta tag, got", v)
+	}
+}
@@ -0,0 +1,654 @@
+package embgui
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	mdHeading  = regexp.MustCompile(`^ {0,3}(#{1,6})(?:[ \t]+(.*?))?(?:[ \t]+#+)?[ \t]*$`)
+	mdFence    = regexp.MustCompile("^( {0,3})(`{3,}|~{3,})[ \t]*([^`\\s]*)")
+	mdRule     = regexp.MustCompile(`^ {0,3}(?:(?:-[ \t]*){3,}|(?:\*[ \t]*){3,}|(?:_[ \t]*){3,})$`)
+	mdSetext   = regexp.MustCompile(`^ {0,3}(=+|-+)[ \t]*$`)
+	mdQuote    = regexp.MustCompile(`^ {0,3}> ?`)
+	mdListItem = regexp.MustCompile(`^( {0,3})([-*+]|\d{1,9}[.)])([ \t]+|$)`)
+	mdTableSep = regexp.MustCompile(`^ {0,3}\|?[ \t]*:?-+:?[ \t]*(\|[ \t]*:?-+:?[ \t]*)*\|?[ \t]*$`)
+)
+
+// safeSchemes are URL schemes allowed in Markdown links and images
+var safeSchemes = map[string]bool{"http": true, "https": true, "mailto": true, "ftp": true}
+
+// sanitizeURL returns the URL if it's relative or uses a safe scheme, it returns an empty string otherwise
+// (like javascript: or data:), so a link has no href at all
+// whitespace and control characters are removed first, as browsers ignore them in schemes
+func sanitizeURL(raw string) string {
+	u := strings.Map(func(r rune) rune {
+		if r <= ' ' || r == 0x7f {
+			return -1
+		}
+		return r
+	}, raw)
+	colon := strings.IndexByte(u, ':')
+	if colon < 0 || strings.ContainsAny(u[:colon], "/?#") || safeSchemes[strings.ToLower(u[:colon])] {
+		return u
+	}
+	return ""
+}
+
+// indentOf returns a number of leading spaces
+func indentOf(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " "))
+}
+
+// isBlank tells if a line has only whitespace
+func isBlank(line string) bool {
+	return strings.TrimSpace(line) == ""
+}
+
+// startsBlock tells if a line starts a block that interrupts a paragraph
+func startsBlock(line string) bool {
+	if mdFence.MatchString(line) || mdHeading.MatchString(line) || mdRule.MatchString(line) || mdQuote.MatchString(line) {
+		return true
+	}
+	m := mdListItem.FindStringSubmatch(line)
+	// only non-empty bullets and lists starting with 1 interrupt a paragraph, so "2020. was good" stays a text
+	return m != nil && m[3] != "" && (strings.IndexByte("-*+", m[2][0]) >= 0 || m[2][:len(m[2])-1] == "1")
+}
+
+// Markdown generates components from a Markdown document
+// supported subset of CommonMark: ATX and setext headings, paragraphs, emphasis, inline code, links, images,
+// bullet and ordered lists (nested by indentation), block quotes, fenced and indented code blocks,
+// horizontal rules and GitHub-style tables
+// fenced code is highlighted with Code(), raw HTML is escaped and links with unsafe schemes (like javascript:) are dropped
+//
+//		page.Markdown(runbook)
+func (n *EmbNode) Markdown(src string) *EmbNode {
+	lines := strings.Split(strings.ReplaceAll(src, "\r\n", "\n"), "\n")
+	for i, line := range lines {
+		// leading tabs are expanded, so indentation can be counted in spaces
+		trimmed := strings.TrimLeft(line, " \t")
+		indent := line[:len(line)-len(trimmed)]
+		lines[i] = strings.ReplaceAll(indent, "\t", "    ") + trimmed
+	}
+	doc := n.add(&EmbNode{HTMLTag: "div", Class: "embgui-markdown"})
+	doc.markdownBlocks(lines)
+	return doc
+}
+
+// markdownBlocks parses block structure of lines
+func (n *EmbNode) markdownBlocks(lines []string) {
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		if isBlank(line) {
+			i++
+			continue
+		}
+		if m := mdFence.FindStringSubmatch(line); m != nil {
+			fence := m[2]
+			var code []string
+			for i++; i < len(lines); i++ {
+				closing := strings.TrimSpace(lines[i])
+				if strings.HasPrefix(closing, fence) && strings.Trim(closing, fence[:1]) == "" {
+					i++
+					break
+				}
+				code = append(code, strings.TrimPrefix(lines[i], m[1]))
+			}
+			n.Code(strings.Join(code, "\n"), m[3])
+			continue
+		}
+		if m := mdHeading.FindStringSubmatch(line); m != nil {
+			level := strconv.Itoa(len(m[1]))
+			n.add(&EmbNode{HTMLTag: "h" + level, Class: "title is-" + level}).markdownInline(m[2])
+			i++
+			continue
+		}
+		if mdRule.MatchString(line) {
+			n.Hr()
+			i++
+			continue
+		}
+		if mdQuote.MatchString(line) {
+			var quoted []string
+			for ; i < len(lines) && !isBlank(lines[i]); i++ {
+				if loc := mdQuote.FindStringIndex(lines[i]); loc != nil {
+					quoted = append(quoted, lines[i][loc[1]:])
+				} else if !startsBlock(lines[i]) {
+					// lazy continuation of a quoted paragraph
+					quoted = append(quoted, lines[i])
+				} else {
+					break
+				}
+			}
+			n.add(&EmbNode{HTMLTag: "blockquote"}).markdownBlocks(quoted)
+			continue
+		}
+		if indentOf(line) >= 4 {
+			var code []string
+			for ; i < len(lines) && (indentOf(lines[i]) >= 4 || isBlank(lines[i])); i++ {
+				code = append(code, strings.TrimPrefix(lines[i], "    "))
+			}
+			for len(code) > 0 && isBlank(code[len(code)-1]) {
+				code = code[:len(code)-1]
+			}
+			n.Code(strings.Join(code, "\n"), "")
+			continue
+		}
+		if i+1 < len(lines) && strings.Contains(line, "|") && mdTableSep.MatchString(lines[i+1]) &&
+			len(tableCells(line)) == len(tableCells(lines[i+1])) {
+			i = n.markdownTable(lines, i)
+			continue
+		}
+		if mdListItem.MatchString(line) {
+			i = n.markdownList(lines, i)
+			continue
+		}
+		var paragraph []string
+		heading := ""
+		for ; i < len(lines) && !isBlank(lines[i]); i++ {
+			if m := mdSetext.FindStringSubmatch(lines[i]); m != nil && len(paragraph) > 0 {
+				heading = "2"
+				if m[1][0] == '=' {
+					heading = "1"
+				}
+				i++
+				break
+			}
+			if len(paragraph) > 0 && startsBlock(lines[i]) {
+				break
+			}
+			paragraph = append(paragraph, strings.TrimSpace(lines[i]))
+		}
+		text := strings.Join(paragraph, "\n")
+		if heading != "" {
+			n.add(&EmbNode{HTMLTag: "h" + heading, Class: "title is-" + heading}).markdownInline(text)
+		} else {
+			n.add(&EmbNode{HTMLTag: "p"}).markdownInline(text)
+		}
+	}
+}
+
+// tableCells splits a table row into cells, pipes inside code spans and escaped pipes don't split cells
+func tableCells(line string) []string {
+	line = strings.TrimSpace(line)
+	line = strings.TrimPrefix(line, "|")
+	if strings.HasSuffix(line, "|") && !strings.HasSuffix(line, `\|`) {
+		line = line[:len(line)-1]
+	}
+	var cells []string
+	var cell strings.Builder
+	inCode := false
+	for i := 0; i < len(line); i++ {
+		switch {
+		case line[i] == '\\' && i+1 < len(line) && line[i+1] == '|':
+			cell.WriteByte('|')
+			i++
+		case line[i] == '`':
+			inCode = !inCode
+			cell.WriteByte('`')
+		case line[i] == '|' && !inCode:
+			cells = append(cells, strings.TrimSpace(cell.String()))
+			cell.Reset()
+		default:
+			cell.WriteByte(line[i])
+		}
+	}
+	return append(cells, strings.TrimSpace(cell.String()))
+}
+
+// markdownTable generates a table from a header, a delimiter row and body rows, it returns the next line to parse
+func (n *EmbNode) markdownTable(lines []string, i int) int {
+	header := tableCells(lines[i])
+	aligns := make([]string, len(header))
+	for j, sep := range tableCells(lines[i+1]) {
+		switch {
+		case strings.HasPrefix(sep, ":") && strings.HasSuffix(sep, ":"):
+			aligns[j] = "text-align: center"
+		case strings.HasSuffix(sep, ":"):
+			aligns[j] = "text-align: right"
+		}
+	}
+	table := n.add(&EmbNode{HTMLTag: "table", Class: "table is-narrow is-hoverable is-fullwidth"})
+	headRow := table.add(&EmbNode{HTMLTag: "thead"}).add(&EmbNode{HTMLTag: "tr"})
+	for j, cell := range header {
+		headRow.add(&EmbNode{HTMLTag: "th", Style: aligns[j]}).markdownInline(cell)
+	}
+	tbody := table.add(&EmbNode{HTMLTag: "tbody"})
+	for i += 2; i < len(lines) && !isBlank(lines[i]) && !startsBlock(lines[i]); i++ {
+		cells := tableCells(lines[i])
+		row := tbody.Tr()
+		for j := range header {
+			td := row.add(&EmbNode{HTMLTag: "td", Style: aligns[j]})
+			if j < len(cells) {
+				td.markdownInline(cells[j])
+			}
+		}
+	}
+	return i
+}
+
+// markdownList generates a list of items of the same type, it returns the next line to parse
+// items are parsed as blocks, paragraphs of tight lists (without blank lines between items) are unwrapped
+func (n *EmbNode) markdownList(lines []string, i int) int {
+	first := mdListItem.FindStringSubmatch(lines[i])
+	marker := first[2][len(first[2])-1:]
+	tag := "ol"
+	if strings.Contains("-*+", first[2]) {
+		tag, marker = "ul", first[2]
+	}
+	list := n.add(&EmbNode{HTMLTag: tag})
+	var items [][]string
+	loose := false
+	for {
+		m := mdListItem.FindStringSubmatch(lines[i])
+		contentIndent := len(m[0])
+		if m[3] == "" || len(m[3]) > 4 {
+			contentIndent = len(m[1]) + len(m[2]) + 1
+		}
+		item := []string{strings.TrimLeft(lines[i][len(m[0]):], " ")}
+		for i++; i < len(lines); i++ {
+			line := lines[i]
+			if isBlank(line) {
+				j := i
+				for j < len(lines) && isBlank(lines[j]) {
+					j++
+				}
+				if j == len(lines) || indentOf(lines[j]) < contentIndent {
+					break
+				}
+				item = append(item, "")
+				loose = true
+				continue
+			}
+			if indentOf(line) >= contentIndent {
+				item = append(item, line[contentIndent:])
+				continue
+			}
+			if !startsBlock(line) && !mdListItem.MatchString(line) && !isBlank(item[len(item)-1]) {
+				// lazy continuation of item's paragraph
+				item = append(item, strings.TrimSpace(line))
+				continue
+			}
+			break
+		}
+		items = append(items, item)
+		j := i
+		for j < len(lines) && isBlank(lines[j]) {
+			j++
+		}
+		if j == len(lines) {
+			break
+		}
+		next := mdListItem.FindStringSubmatch(lines[j])
+		if next == nil || (tag == "ul" && next[2] != marker) || (tag == "ol" && !strings.HasSuffix(next[2], marker)) ||
+			strings.Contains("-*+", next[2]) != (tag == "ul") {
+			break
+		}
+		loose = loose || j > i
+		i = j
+	}
+	for _, item := range items {
+		li := list.add(&EmbNode{HTMLTag: "li"})
+		li.markdownBlocks(item)
+		if loose {
+			continue
+		}
+		if len(li.Children) == 1 && li.Children[0].HTMLTag == "p" && len(li.Children[0].Children) == 0 {
+			li.Text, li.Children = li.Children[0].Text, nil
+			continue
+		}
+		for _, child := range li.Children {
+			if child.HTMLTag == "p" {
+				child.HTMLTag = "span"
+			}
+		}
+	}
+	return i
+}
+
+// isPunct tells if a character can be escaped with a backslash
+func isPunct(c byte) bool {
+	return strings.IndexByte("!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~", c) >= 0
+}
+
+
+// emphasisTags maps delimiters to HTML tags
+var emphasisTags = map[string]string{"*": "em", "_": "em", "**": "strong", "__": "strong", "~~": "del"}
+
+// mdInline parses inline elements of a text, each part of the text is scanned a constant number of times,
+// so long or malicious input (like thousands of unclosed [ or *) doesn't stall rendering
+type mdInline struct {
+	text string
+	// pairs holds a position of the matching ] or ) for every [ and (, -1 if there's none
+	pairs []int
+	// noCode maps lengths of backtick runs without a closing run to the end of the failed search
+	noCode map[int]int
+}
+
+// mdItem is a parsed piece of inline text: plain text, a component or a run of emphasis delimiters
+// delimiters are kept on a separate list (prevDelim, nextDelim) until they're matched
+type mdItem struct {
+	text      string
+	node      *EmbNode
+	inner     []*mdItem
+	delim     byte
+	count     int
+	length    int
+	pos       int
+	open      bool
+	close     bool
+	prev      *mdItem
+	next      *mdItem
+	prevDelim *mdItem
+	nextDelim *mdItem
+}
+
+// mdBottom identifies closers that can match the same openers (see mdInline.emphasis())
+type mdBottom struct {
+	delim byte
+	mod   int
+	open  bool
+}
+
+// newMDInline finds matching brackets and parentheses of a text, escaped ones are skipped
+func newMDInline(text string) *mdInline {
+	p := &mdInline{text: text, pairs: make([]int, len(text)), noCode: map[int]int{}}
+	var brackets, parens []int
+	for i := range p.pairs {
+		p.pairs[i] = -1
+	}
+	for i := 0; i < len(text); i++ {
+		switch text[i] {
+		case '\\':
+			i++
+		case '[':
+			brackets = append(brackets, i)
+		case '(':
+			parens = append(parens, i)
+		case ']':
+			if len(brackets) > 0 {
+				p.pairs[brackets[len(brackets)-1]] = i
+				brackets = brackets[:len(brackets)-1]
+			}
+		case ')':
+			if len(parens) > 0 {
+				p.pairs[parens[len(parens)-1]] = i
+				parens = parens[:len(parens)-1]
+			}
+		}
+	}
+	return p
+}
+
+// link parses [label](url "title") starting at i and ending before to,
+// it returns the end of the label, URL and the end of the link
+func (p *mdInline) link(i int, to int) (int, string, int, bool) {
+	closing := p.pairs[i]
+	if closing < 0 || closing+1 >= to || p.text[closing+1] != '(' {
+		return 0, "", 0, false
+	}
+	last := p.pairs[closing+1]
+	if last < 0 || last >= to {
+		return 0, "", 0, false
+	}
+	target := strings.TrimSpace(p.text[closing+2 : last])
+	// optional title is skipped
+	if space := strings.IndexAny(target, " \t\n"); space >= 0 {
+		target = target[:space]
+	}
+	return closing, strings.TrimSuffix(strings.TrimPrefix(target, "<"), ">"), last + 1, true
+}
+
+// codeSpan returns the end of a code span starting at i with a run of backticks, -1 if it isn't closed before to
+func (p *mdInline) codeSpan(i int, run int, to int) int {
+	if limit, ok := p.noCode[run]; ok && to <= limit {
+		return -1
+	}
+	end := strings.Index(p.text[i+run:to], p.text[i:i+run])
+	if end < 0 {
+		p.noCode[run] = to
+	}
+	return end
+}
+
+// parse adds inline elements of text[from:to] to a node, plain text is set as node's text if there's nothing else
+func (p *mdInline) parse(n *EmbNode, from int, to int) {
+	text := p.text
+	head := &mdItem{}
+	tail := head
+	var firstDelim, lastDelim *mdItem
+	add := func(item *mdItem) {
+		item.prev, tail.next, tail = tail, item, item
+	}
+	var plain strings.Builder
+	flush := func() {
+		if plain.Len() > 0 {
+			add(&mdItem{text: plain.String()})
+			plain.Reset()
+		}
+	}
+	for i := from; i < to; {
+		c := text[i]
+		switch {
+		case c == '\\' && i+1 < to && isPunct(text[i+1]):
+			plain.WriteByte(text[i+1])
+			i += 2
+			continue
+		case c == '`':
+			run := 1
+			for i+run < to && text[i+run] == '`' {
+				run++
+			}
+			if end := p.codeSpan(i, run, to); end >= 0 {
+				flush()
+				code := strings.ReplaceAll(text[i+run:i+run+end], "\n", " ")
+				if len(code) > 2 && code[0] == ' ' && code[len(code)-1] == ' ' {
+					code = code[1 : len(code)-1]
+				}
+				add(&mdItem{node: &EmbNode{HTMLTag: "code", Text: code}})
+				i += 2*run + end
+				continue
+			}
+			plain.WriteString(text[i : i+run])
+			i += run
+			continue
+		case c == '!' && i+1 < to && text[i+1] == '[':
+			if closing, target, end, ok := p.link(i+1, to); ok {
+				flush()
+				add(&mdItem{node: &EmbNode{HTMLTag: "img", Src: sanitizeURL(target), Alt: text[i+2 : closing]}})
+				i = end
+				continue
+			}
+		case c == '[':
+			if closing, target, end, ok := p.link(i, to); ok {
+				flush()
+				link := &EmbNode{HTMLTag: "a", Href: sanitizeURL(target)}
+				p.parse(link, i+1, closing)
+				add(&mdItem{node: link})
+				i = end
+				continue
+			}
+		case c == '<':
+			// the search stops at the next <, so every character is searched once
+			if end := strings.IndexAny(text[i+1:to], "<>"); end > 0 && text[i+1+end] == '>' {
+				target := text[i+1 : i+1+end]
+				if !strings.ContainsAny(target, " \t\n") && (strings.Contains(target, "://") || strings.Contains(target, "@")) {
+					flush()
+					href := target
+					if !strings.Contains(target, ":") {
+						href = "mailto:" + target
+					}
+					add(&mdItem{node: &EmbNode{HTMLTag: "a", Href: sanitizeURL(href), Text: target}})
+					i += end + 2
+					continue
+				}
+			}
+		case c == '*' || c == '_' || c == '~':
+			end := i + 1
+			for end < to && text[end] == c {
+				end++
+			}
+			if c == '~' && end-i != 2 {
+				plain.WriteString(text[i:end])
+				i = end
+				continue
+			}
+			flush()
+			before, after := byte(' '), byte(' ')
+			if i > from {
+				before = text[i-1]
+			}
+			if end < to {
+				after = text[end]
+			}
+			// closing delimiter can't follow a space and _ can't be used inside a word
+			d := &mdItem{delim: c, count: end - i, length: end - i, pos: i,
+				open:  after != ' ' && after != '\n' && (c != '_' || !isWordChar(before)),
+				close: before != ' ' && before != '\n' && (c != '_' || !isWordChar(after))}
+			add(d)
+			if lastDelim != nil {
+				lastDelim.nextDelim, d.prevDelim = d, lastDelim
+			} else {
+				firstDelim = d
+			}
+			lastDelim = d
+			i = end
+			continue
+		}
+		plain.WriteByte(c)
+		i++
+	}
+	flush()
+	emphasis(firstDelim)
+	var items []*mdItem
+	for item := head.next; item != nil; item = item.next {
+		items = append(items, item)
+	}
+	n.addInline(items)
+}
+
+// emphasis matches runs of delimiters with CommonMark's delimiter stack algorithm
+// every closer is matched with the nearest opener of the same character, items between them are wrapped into
+// em, strong or del, so *a **b** c* is em with strong inside and ***a*** is strong with em inside
+// bottoms remember below which delimiter there's no opener for a kind of closers, so openers aren't searched twice
+func emphasis(first *mdItem) {
+	// remove takes a delimiter off the delimiter list
+	remove := func(d *mdItem) {
+		if d.prevDelim != nil {
+			d.prevDelim.nextDelim = d.nextDelim
+		}
+		if d.nextDelim != nil {
+			d.nextDelim.prevDelim = d.prevDelim
+		}
+	}
+	// unlink takes an used up delimiter out of the items
+	unlink := func(d *mdItem) {
+		d.prev.next = d.next
+		if d.next != nil {
+			d.next.prev = d.prev
+		}
+		remove(d)
+	}
+	bottoms := map[mdBottom]int{}
+	for closer := first; closer != nil; {
+		if !closer.close {
+			closer = closer.nextDelim
+			continue
+		}
+		kind := mdBottom{closer.delim, closer.length % 3, closer.open}
+		bottom, ok := bottoms[kind]
+		if !ok {
+			bottom = -1
+		}
+		opener := closer.prevDelim
+		for opener != nil && opener.pos > bottom && !matchesCloser(opener, closer) {
+			opener = opener.prevDelim
+		}
+		if opener == nil || opener.pos <= bottom {
+			bottoms[kind] = -1
+			if closer.prevDelim != nil {
+				bottoms[kind] = closer.prevDelim.pos
+			}
+			next := closer.nextDelim
+			if !closer.open {
+				remove(closer)
+			}
+			closer = next
+			continue
+		}
+		use := 1
+		if closer.delim == '~' || opener.count >= 2 && closer.count >= 2 && (opener.count%2 == 0 || closer.count%2 == 0) {
+			use = 2
+		}
+		wrap := &mdItem{node: &EmbNode{HTMLTag: emphasisTags[strings.Repeat(string(closer.delim), use)]}, inner: []*mdItem{}}
+		for item := opener.next; item != closer; item = item.next {
+			wrap.inner = append(wrap.inner, item)
+		}
+		opener.next, wrap.prev, wrap.next, closer.prev = wrap, opener, closer, wrap
+		// delimiters between the opener and the closer are plain text now
+		opener.nextDelim, closer.prevDelim = closer, opener
+		opener.count -= use
+		closer.count -= use
+		if opener.count == 0 {
+			unlink(opener)
+		}
+		if closer.count == 0 {
+			next := closer.nextDelim
+			unlink(closer)
+			closer = next
+		}
+	}
+}
+
+// matchesCloser tells if a delimiter run can be closed by another one, runs that both open and close emphasis
+// can't match if their lengths add up to a multiple of 3 (unless both are multiples of 3), so *foo**bar* is em
+func matchesCloser(opener *mdItem, closer *mdItem) bool {
+	if !opener.open || opener.delim != closer.delim {
+		return false
+	}
+	if closer.delim != '~' && (opener.close || closer.open) && (opener.length+closer.length)%3 == 0 {
+		return opener.length%3 == 0 && closer.length%3 == 0
+	}
+	return true
+}
+
+// addInline adds parsed items to a node, adjacent plain text is merged into spans
+// plain text is set as node's text if there's nothing else
+func (n *EmbNode) addInline(items []*mdItem) {
+	var nodes []*EmbNode
+	var plain strings.Builder
+	flush := func() {
+		if plain.Len() > 0 {
+			nodes = append(nodes, &EmbNode{HTMLTag: "span", Text: plain.String()})
+			plain.Reset()
+		}
+	}
+	for _, item := range items {
+		switch {
+		case item.delim != 0:
+			plain.WriteString(strings.Repeat(string(item.delim), item.count))
+		case item.node == nil:
+			plain.WriteString(item.text)
+		default:
+			flush()
+			if item.inner != nil {
+				item.node.addInline(item.inner)
+			}
+			nodes = append(nodes, item.node)
+		}
+	}
+	flush()
+	if len(nodes) == 1 && nodes[0].HTMLTag == "span" {
+		n.Text = nodes[0].Text
+		return
+	}
+	for _, node := range nodes {
+		n.add(node)
+	}
+}
+
+// markdownInline parses inline elements of text, plain text is set as node's text if there's nothing else
+func (n *EmbNode) markdownInline(text string) {
+	newMDInline(text).parse(n, 0, len(text))
+}
@@ -0,0 +1,105 @@
+package embgui
+
+import (
+	"html"
+	"strings"
+	"testing"
+)
+
+func TestMarkdown(t *testing.T) {
+	tests := []struct {
+		src      string
+		expected string
+	}{
+		{"# Runbook #\n\nRestart *the* **worker**,\nthen check `ps aux | grep w`.",
+			`<h1 class='title is-1'>Runbook</h1><p><span>Restart </span><em>the</em><span> </span><strong>worker</strong>` +
+				"<span>,\nthen check </span><code>ps aux | grep w</code><span>.</span></p>"},
+		{"Release notes\n===\n\nsnake_case_name and 2 * 3 * 4 and \\*not em\\*",
+			`<h1 class='title is-1'>Release notes</h1><p>snake_case_name and 2 * 3 * 4 and *not em*</p>`},
+		{"- one\n- two\n  - nested\n- three\n\n1. first\n2. second",
+			`<ul><li>one</li><li><span>two</span><ul><li>nested</li></ul></li><li>three</li></ul>` +
+				`<ol><li>first</li><li>second</li></ol>`},
+		{"* loose\n\n* list",
+			`<ul><li><p>loose</p></li><li><p>list</p></li></ul>`},
+		{"| Name | Size |\n|:-----|-----:|\n| `a|b` | 10 |\n| c \\| d |",
+			`<table class='table is-narrow is-hoverable is-fullwidth'><thead><tr><th>Name</th>` +
+				`<th style='text-align: right'>Size</th></tr></thead><tbody><tr><td><code>a|b</code></td>` +
+				`<td style='text-align: right'>10</td></tr><tr><td>c | d</td>` +
+				`<td style='text-align: right'></td></tr></tbody></table>`},
+		{"[docs](https://example.com/a_(b) \"title\") [x](javascript:alert(1)) [y](JaVaScript:alert(1)) " +
+			"![logo](/img/logo.png) <https://example.com> <ops@example.com>",
+			`<p><a href='https://example.com/a_(b)'>docs</a><span> </span><a>x</a><span> </span><a>y</a><span> </span>` +
+				`<img src='/img/logo.png' alt='logo'></img><span> </span><a href='https://example.com'>https://example.com</a>` +
+				`<span> </span><a href='mailto:ops@example.com'>ops@example.com</a></p>`},
+		{"> quoted <b>\nlazy\n\n---\n\n    indented code",
+			`<blockquote><p>quoted &lt;b&gt;` + "\n" + `lazy</p></blockquote><hr class='hr'></hr>` +
+				`<pre class='embgui-code'><code><span class='embgui-code-line'><span>indented code</span></span></code></pre>`},
+		{"```json\n{\"a\": 1}\n```\n***bold em*** ~~gone~~",
+			`<pre class='embgui-code'><code class='language-json'><span class='embgui-code-line'><span>{</span>` +
+				`<span class='embgui-key'>&#34;a&#34;</span><span>: </span><span class='embgui-num'>1</span><span>}</span></span></code></pre>` +
+				`<p><strong><em>bold em</em></strong><span> </span><del>gone</del></p>`},
+		{"*a **b** c* _a __b__ c_ **a *b* c** *foo**bar*",
+			`<p><em><span>a </span><strong>b</strong><span> c</span></em><span> </span>` +
+				`<em><span>a </span><strong>b</strong><span> c</span></em><span> </span>` +
+				`<strong><span>a </span><em>b</em><span> c</span></strong><span> </span><em>foo**bar</em></p>`},
+		{"*a `*` b* ***a** b* **a* *a\\* [*a*](b) ~~~a~~~",
+			`<p><em><span>a </span><code>*</code><span> b</span></em><span> </span>` +
+				`<em><strong>a</strong><span> b</span></em><span> *</span><em>a</em><span> *a* </span>` +
+				`<a href='b'><em>a</em></a><span> ~~~a~~~</span></p>`},
+	}
+	for _, test := range tests {
+		page := preparePage()
+		if page == nil {
+			t.Errorf("can't initialize test page")
+		}
+		v := page.Markdown(test.src).render()
+		expectedResult := `<div class='embgui-markdown'>` + test.expected + `</div>`
+		if v != expectedResult {
+			t.Error(
+				"For", test.src,
+				"expected", expectedResult,
+				"got", v,
+			)
+		}
+	}
+}
+
+func TestMarkdownUnclosed(t *testing.T) {
+	// unclosed delimiters are searched once, so large input is rendered quickly
+	var ticks strings.Builder
+	for i := 300; i > 0; i-- {
+		ticks.WriteString(" a " + strings.Repeat("`", i))
+	}
+	for _, text := range []string{strings.Repeat("*a ", 20000), strings.Repeat("[", 40000),
+		ticks.String(), strings.Repeat("<a", 20000), strings.Repeat("[a](", 10000)} {
+		page := preparePage()
+		if page == nil {
+			t.Errorf("can't initialize test page")
+		}
+		v := page.Markdown(text).render()
+		if !strings.Contains(v, html.EscapeString(strings.TrimSpace(text))) {
+			t.Error("For", "TestMarkdownUnclosed", text[:10], "expected plain text")
+		}
+	}
+}
+
+func TestSanitizeURL(t *testing.T) {
+	for url, expected := range map[string]string{
+		"https://example.com": "https://example.com",
+		"/runbooks/db?x=1#a":  "/runbooks/db?x=1#a",
+		"a/b:c":               "a/b:c",
+		"MAILTO:ops@x.io":     "MAILTO:ops@x.io",
+		"javascript:alert(1)": "",
+		" java\nscript:x":     "",
+		"data:text/html,x":    "",
+		"vbscript:x":          "",
+	} {
+		if v := sanitizeURL(url); v != expected {
+			t.Error(
+				"For", url,
+				"expected", expected,
+				"got", v,
+			)
+		}
+	}
+}
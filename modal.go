@@ -0,0 +1,24 @@
+package embgui
+
+// Modal generates bulma's modal dialog that works without JavaScript
+// it opens with a link to "#id" (see ModalLink()) thanks to CSS :target and closes with a link
+// text-based browsers ignore CSS, so they show modal's content inline
+// it returns modal's body, that can hold any other component
+//
+//		page.ModalLink("Show JSON", "raw-json")
+//		page.Modal("raw-json", "Raw JSON").Pre(rawJSON, "")
+func (n *EmbNode) Modal(id string, title string) *EmbNode {
+	modal := n.add(&EmbNode{HTMLTag: "div", Class: "modal", ID: id})
+	// "#_" doesn't match any element, so closing doesn't scroll the page to the top
+	modal.add(&EmbNode{HTMLTag: "a", Class: "modal-background", Href: "#_"})
+	card := modal.add(&EmbNode{HTMLTag: "div", Class: "modal-card"})
+	head := card.add(&EmbNode{HTMLTag: "header", Class: "modal-card-head"})
+	head.add(&EmbNode{HTMLTag: "p", Class: "modal-card-title", Text: title})
+	head.add(&EmbNode{HTMLTag: "a", Class: "delete", Href: "#_", Text: "close"})
+	return card.add(&EmbNode{HTMLTag: "section", Class: "modal-card-body"})
+}
+
+// ModalLink generates a button that opens a modal with a given id
+func (n *EmbNode) ModalLink(text string, id string) *EmbNode {
+	return n.add(&EmbNode{Text: text, HTMLTag: "a", Href: "#" + id, Class: "button is-link", Style: "margin: .25rem"})
+}
@@ -0,0 +1,31 @@
+package embgui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestModal(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	page.ModalLink("Show JSON", "raw-json")
+	page.Modal("raw-json", "Raw JSON").Pre("{}", "")
+	v := page.render()
+	expectedResult := `<><a class='button is-link' href='#raw-json' style='margin: .25rem'>Show JSON</a>` +
+		`<div class='modal' id='raw-json'><a class='modal-background' href='#_'></a><div class='modal-card'>` +
+		`<header class='modal-card-head'><p class='modal-card-title'>Raw JSON</p><a class='delete' href='#_'>close</a></header>` +
+		`<section class='modal-card-body'><pre>{}</pre></section></div></div></>`
+	if v != expectedResult {
+		t.Error(
+			"For", "TestModal",
+			"expected", expectedResult,
+			"got", v,
+		)
+	}
+	html, _ := page.RenderPage()
+	if strings.Contains(html, ".modal:target{display:flex}") == false {
+		t.Error("For", "TestModal", "expected :target style in the page")
+	}
+}
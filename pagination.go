@@ -0,0 +1,139 @@
+package embgui
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// maxInt is the largest int value
+const maxInt = int(^uint(0) >> 1)
+
+// Paging computes offset and limit from ?page= and ?per_page= query parameters
+// pages are numbered from 1, call SetTotal() once the number of items is known
+// see NewPaging() and Pagination()
+type Paging struct {
+	Page    int
+	PerPage int
+	Total   int
+	request *http.Request
+}
+
+// NewPaging reads page and per_page query parameters from a request
+// missing or invalid values fall back to the first page and defaultPerPage,
+// per_page is clamped to <1, maxPerPage> and page is clamped so the offset fits in an int
+//
+//		paging := embgui.NewPaging(r, 20, 100)
+//		paging.SetTotal(countUsers())
+//		users := listUsers(paging.Offset(), paging.Limit())
+//		page.Pagination(paging)
+func NewPaging(r *http.Request, defaultPerPage int, maxPerPage int) *Paging {
+	p := &Paging{Page: 1, PerPage: defaultPerPage, request: r}
+	if page, err := strconv.Atoi(queryParam(r, "page")); err == nil && page > 0 {
+		p.Page = page
+	}
+	if perPage, err := strconv.Atoi(queryParam(r, "per_page")); err == nil {
+		p.PerPage = perPage
+	}
+	if maxPerPage > 0 && p.PerPage > maxPerPage {
+		p.PerPage = maxPerPage
+	}
+	if p.PerPage < 1 {
+		p.PerPage = 1
+	}
+	// huge pages are clamped, so Offset() doesn't overflow before SetTotal() is called
+	if p.Page > maxInt/p.PerPage {
+		p.Page = maxInt / p.PerPage
+	}
+	return p
+}
+
+// SetTotal sets the number of all items and clamps current page to the last page
+func (p *Paging) SetTotal(total int) {
+	p.Total = total
+	if p.Page > p.Pages() {
+		p.Page = p.Pages()
+	}
+}
+
+// Pages returns the number of pages, there's always at least one page
+func (p *Paging) Pages() int {
+	pages := (p.Total + p.PerPage - 1) / p.PerPage
+	if pages < 1 {
+		return 1
+	}
+	return pages
+}
+
+// Offset returns the number of items to skip
+func (p *Paging) Offset() int {
+	return (p.Page - 1) * p.PerPage
+}
+
+// Limit returns the number of items on a page
+func (p *Paging) Limit() int {
+	return p.PerPage
+}
+
+// Link returns a link to a given page, other query parameters are kept
+func (p *Paging) Link(page int) string {
+	return queryLink(p.request, "page", strconv.Itoa(page))
+}
+
+// pageWindow returns page numbers to show, 0 stands for an ellipsis
+// the first, the last and pages around the current one are shown
+func pageWindow(current int, pages int) []int {
+	if current > pages {
+		current = pages
+	}
+	window := []int{1}
+	// add appends a page after the last one, a single hidden page is shown instead of an ellipsis
+	add := func(page int) {
+		last := window[len(window)-1]
+		switch {
+		case page <= last || page > pages:
+			return
+		case page == last+2:
+			window = append(window, last+1)
+		case page > last+2:
+			window = append(window, 0)
+		}
+		window = append(window, page)
+	}
+	for d := -2; d <= 2; d++ {
+		add(current + d)
+	}
+	add(pages)
+	return window
+}
+
+// Pagination generates bulma's pagination with previous/next links, the first and the last page,
+// ellipses and the current page highlighted
+// links keep other query parameters, like filters and sorting
+func (n *EmbNode) Pagination(p *Paging) *EmbNode {
+	nav := n.add(&EmbNode{HTMLTag: "nav", Class: "pagination is-centered"})
+	previous := nav.add(&EmbNode{HTMLTag: "a", Class: "pagination-previous", Text: "Previous"})
+	if p.Page > 1 {
+		previous.Href = p.Link(p.Page - 1)
+	} else {
+		previous.Disabled = true
+	}
+	next := nav.add(&EmbNode{HTMLTag: "a", Class: "pagination-next", Text: "Next"})
+	if p.Page < p.Pages() {
+		next.Href = p.Link(p.Page + 1)
+	} else {
+		next.Disabled = true
+	}
+	list := nav.add(&EmbNode{HTMLTag: "ul", Class: "pagination-list"})
+	for _, page := range pageWindow(p.Page, p.Pages()) {
+		item := list.add(&EmbNode{HTMLTag: "li"})
+		if page == 0 {
+			item.add(&EmbNode{HTMLTag: "span", Class: "pagination-ellipsis", Text: "…"})
+			continue
+		}
+		link := item.add(&EmbNode{HTMLTag: "a", Class: "pagination-link", Href: p.Link(page), Text: strconv.Itoa(page)})
+		if page == p.Page {
+			link.Class = "pagination-link is-current"
+		}
+	}
+	return nav
+}
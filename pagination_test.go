@@ -0,0 +1,91 @@
+package embgui
+
+import (
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestPaging(t *testing.T) {
+	tests := []struct {
+		url     string
+		total   int
+		page    int
+		perPage int
+		offset  int
+	}{
+		{"/users", 95, 1, 20, 0},
+		{"/users?page=3&per_page=10", 95, 3, 10, 20},
+		{"/users?page=30", 95, 5, 20, 80},
+		{"/users?page=-1&per_page=1000", 95, 1, 50, 0},
+		{"/users?page=abc&per_page=0", 0, 1, 1, 0},
+		{"/users?page=" + strconv.Itoa(maxInt), 95, 5, 20, 80},
+	}
+	for _, test := range tests {
+		p := NewPaging(httptest.NewRequest("GET", test.url, nil), 20, 50)
+		p.SetTotal(test.total)
+		if p.Page != test.page || p.Limit() != test.perPage || p.Offset() != test.offset {
+			t.Error(
+				"For", "TestPaging", test.url,
+				"expected", test.page, test.perPage, test.offset,
+				"got", p.Page, p.Limit(), p.Offset(),
+			)
+		}
+	}
+	// offset doesn't overflow when total isn't known
+	p := NewPaging(httptest.NewRequest("GET", "/users?page="+strconv.Itoa(maxInt), nil), 20, 50)
+	if p.Offset() < 0 || p.Offset() > maxInt-p.Limit() {
+		t.Error("For", "TestPaging", "expected offset without overflow, got", p.Offset())
+	}
+}
+
+func TestPageWindow(t *testing.T) {
+	tests := []struct {
+		current  int
+		pages    int
+		expected []int
+	}{
+		{1, 1, []int{1}},
+		{1, 10, []int{1, 2, 3, 0, 10}},
+		{5, 10, []int{1, 2, 3, 4, 5, 6, 7, 0, 10}},
+		{6, 12, []int{1, 0, 4, 5, 6, 7, 8, 0, 12}},
+		{10, 10, []int{1, 0, 8, 9, 10}},
+		{3, 5, []int{1, 2, 3, 4, 5}},
+		{4, 7, []int{1, 2, 3, 4, 5, 6, 7}},
+		{50, 7, []int{1, 0, 5, 6, 7}},
+		{500000, maxInt, []int{1, 0, 499998, 499999, 500000, 500001, 500002, 0, maxInt}},
+	}
+	for _, test := range tests {
+		v := pageWindow(test.current, test.pages)
+		if !reflect.DeepEqual(v, test.expected) {
+			t.Error(
+				"For", "TestPageWindow", test.current, test.pages,
+				"expected", test.expected,
+				"got", v,
+			)
+		}
+	}
+}
+
+func TestPagination(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	p := NewPaging(httptest.NewRequest("GET", "/users?search=john&page=1", nil), 10, 100)
+	p.SetTotal(25)
+	v := page.Pagination(p).render()
+	expectedResult := `<nav class='pagination is-centered'><a class='pagination-previous' disabled>Previous</a>` +
+		`<a class='pagination-next' href='/users?page=2&amp;search=john'>Next</a><ul class='pagination-list'>` +
+		`<li><a class='pagination-link is-current' href='/users?page=1&amp;search=john'>1</a></li>` +
+		`<li><a class='pagination-link' href='/users?page=2&amp;search=john'>2</a></li>` +
+		`<li><a class='pagination-link' href='/users?page=3&amp;search=john'>3</a></li></ul></nav>`
+	if v != expectedResult {
+		t.Error(
+			"For", "TestPagination",
+			"expected", expectedResult,
+			"got", v,
+		)
+	}
+}
@@ -0,0 +1,156 @@
+package embgui
+
+import (
+	"math"
+	"strconv"
+)
+
+// otherColor is used for a slice that groups small slices together
+const otherColor = "#b5b5b5"
+
+// Slice is a single labeled value of a pie chart
+// see PieChart()
+type Slice struct {
+	Label string
+	Value float64
+}
+
+// PieOptions customizes PieChart and DonutChart
+// slices smaller than OtherBelow percent are grouped into a single "other" slice
+// ShowTotal puts a sum of all values in the center of a donut
+type PieOptions struct {
+	OtherBelow float64
+	ShowTotal  bool
+}
+
+// PieChart generates server-side rendered SVG pie chart with percentage labels and a legend
+// slices with values less or equal to 0, NaN or infinite are skipped
+// a percentage list is attached for text-based browsers
+//
+//		page.PieChart("disk usage", embgui.PieOptions{OtherBelow: 2},
+//		embgui.Slice{Label: "/", Value: 40}, embgui.Slice{Label: "/var", Value: 120})
+func (n *EmbNode) PieChart(title string, opts PieOptions, slices ...Slice) *EmbNode {
+	return n.pieChart(title, opts, false, slices)
+}
+
+// DonutChart generates pie chart with a hole, see PieChart()
+// it can show a total in the center
+func (n *EmbNode) DonutChart(title string, opts PieOptions, slices ...Slice) *EmbNode {
+	return n.pieChart(title, opts, true, slices)
+}
+
+// fmtPercent formats percentage with a single decimal place
+func fmtPercent(v float64) string {
+	return strconv.FormatFloat(v, 'f', 1, 64) + "%"
+}
+
+// groupSlices removes empty slices and groups the ones below threshold into "other"
+// it returns total of all values and whether "other" slice was appended
+func groupSlices(slices []Slice, otherBelow float64) ([]Slice, float64, bool) {
+	total := 0.0
+	for _, sl := range slices {
+		if sl.Value > 0 && finite(sl.Value) {
+			total += sl.Value
+		}
+	}
+	var grouped []Slice
+	other := Slice{Label: "other"}
+	for _, sl := range slices {
+		if !(sl.Value > 0 && finite(sl.Value)) {
+			continue
+		}
+		if sl.Value/total*100 < otherBelow {
+			other.Value += sl.Value
+			continue
+		}
+		grouped = append(grouped, sl)
+	}
+	if other.Value > 0 {
+		return append(grouped, other), total, true
+	}
+	return grouped, total, false
+}
+
+// pieChart renders pie or donut chart
+func (n *EmbNode) pieChart(title string, opts PieOptions, donut bool, slices []Slice) *EmbNode {
+	slices, total, otherGrouped := groupSlices(slices, opts.OtherBelow)
+	colors := make([]string, len(slices))
+	for i := range slices {
+		colors[i] = seriesColor(i)
+	}
+	if otherGrouped {
+		colors[len(colors)-1] = otherColor
+	}
+
+	cx, cy, r := 170.0, 160.0, 120.0
+	if title != "" {
+		cy += 15
+	}
+	inner := 0.0
+	if donut {
+		inner = r * 0.55
+	}
+	s := newSVG(chartWidth, chartHeight, title)
+	drawTitle(s, title)
+	if total == 0 {
+		s.tag("circle", "", "cx", fmtFloat(cx), "cy", fmtFloat(cy), "r", fmtFloat(r), "fill", gridColor)
+	}
+	angle := -math.Pi / 2
+	for i, sl := range slices {
+		share := sl.Value / total
+		tooltip := sl.Label + ": " + fmtValue(sl.Value) + " (" + fmtPercent(share*100) + ")"
+		if share >= 1 {
+			s.open("circle", "cx", fmtFloat(cx), "cy", fmtFloat(cy), "r", fmtFloat(r), "fill", colors[i])
+		} else {
+			end := angle + share*2*math.Pi
+			large := "0"
+			if share > 0.5 {
+				large = "1"
+			}
+			d := "M" + fmtFloat(cx) + "," + fmtFloat(cy) +
+				" L" + fmtFloat(cx+r*math.Cos(angle)) + "," + fmtFloat(cy+r*math.Sin(angle)) +
+				" A" + fmtFloat(r) + "," + fmtFloat(r) + " 0 " + large + ",1 " +
+				fmtFloat(cx+r*math.Cos(end)) + "," + fmtFloat(cy+r*math.Sin(end)) + " Z"
+			s.open("path", "d", d, "fill", colors[i], "stroke", "#ffffff")
+		}
+		s.tag("title", tooltip)
+		if share >= 1 {
+			s.end("circle")
+		} else {
+			s.end("path")
+		}
+		if share >= 0.05 {
+			mid := angle + share*math.Pi
+			lr := (r + inner) / 2
+			if !donut {
+				lr = r * 0.65
+			}
+			s.tag("text", fmtPercent(share*100), "x", fmtFloat(cx+lr*math.Cos(mid)), "y", fmtFloat(cy+lr*math.Sin(mid)+4),
+				"text-anchor", "middle", "fill", "#ffffff", "font-weight", "bold")
+		}
+		angle += share * 2 * math.Pi
+	}
+	if donut {
+		s.tag("circle", "", "cx", fmtFloat(cx), "cy", fmtFloat(cy), "r", fmtFloat(inner), "fill", "#ffffff")
+		if opts.ShowTotal {
+			s.tag("text", fmtValue(total), "x", fmtFloat(cx), "y", fmtFloat(cy+4), "text-anchor", "middle",
+				"font-size", "22", "font-weight", "bold", "fill", axisColor)
+			s.text(cx, cy+22, "middle", axisColor, "total")
+		}
+	}
+	for i, sl := range slices {
+		y := cy - r + 10 + float64(i)*20
+		s.rect(cx+r+60, y-9, 10, 10, colors[i], "")
+		s.text(cx+r+76, y, "start", axisColor, sl.Label+" "+fmtPercent(sl.Value/total*100))
+	}
+
+	figure := n.chart(s.String())
+	list := figure.textFallback().Ul()
+	for _, sl := range slices {
+		list.Li(sl.Label + ": " + fmtPercent(sl.Value/total*100) + " (" + fmtValue(sl.Value) + ")")
+	}
+	if donut && opts.ShowTotal {
+		list.Li("total: " + fmtValue(total))
+	}
+	return figure
+}
@@ -0,0 +1,69 @@
+package embgui
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestPieChart(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	slices := []Slice{{Label: "/", Value: 60}, {Label: "/var", Value: 39}, {Label: "/tmp", Value: 1}, {Label: "/boot", Value: 0}}
+	v := page.PieChart("disk usage", PieOptions{OtherBelow: 5}, slices...).render()
+	testStrings := []string{`<figure class='embgui-chart'><svg`,
+		`<path d='M170,175 L170,55 A120,120 0 1,1 `,
+		`<title>/var: 39 (39.0%)</title>`,
+		`<li>/: 60.0% (60)</li><li>/var: 39.0% (39)</li><li>other: 1.0% (1)</li></ul>`,
+		`fill='#b5b5b5'`}
+	for _, str := range testStrings {
+		if strings.Contains(v, str) == false {
+			t.Error(
+				"For", "TestPieChart",
+				"expected to have", str,
+				"got", v,
+			)
+		}
+	}
+	if strings.Contains(v, "/boot") {
+		t.Error("For", "TestPieChart", "expected empty slice to be skipped")
+	}
+}
+
+func TestDonutChart(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	v := page.DonutChart("", PieOptions{ShowTotal: true}, Slice{Label: "ok", Value: 42}).render()
+	testStrings := []string{`<circle cx='170' cy='160' r='120' fill='#3273dc'><title>ok: 42 (100.0%)</title></circle>`,
+		`<circle cx='170' cy='160' r='66' fill='#ffffff'/>`,
+		`>42</text>`,
+		`<li>ok: 100.0% (42)</li><li>total: 42</li>`}
+	for _, str := range testStrings {
+		if strings.Contains(v, str) == false {
+			t.Error(
+				"For", "TestDonutChart",
+				"expected to have", str,
+				"got", v,
+			)
+		}
+	}
+}
+
+func TestPieChartNonFinite(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	v := page.DonutChart("", PieOptions{ShowTotal: true}, Slice{Label: "ok", Value: 42},
+		Slice{Label: "inf", Value: math.Inf(1)}, Slice{Label: "nan", Value: math.NaN()}).render()
+	if strings.Contains(v, "NaN") || strings.Contains(v, "Inf") || strings.Contains(v, "inf") || strings.Contains(v, "nan") {
+		t.Error("For", "TestPieChartNonFinite", "expected non-finite slices to be skipped, got", v)
+	}
+	if !strings.Contains(v, "<li>ok: 100.0% (42)</li><li>total: 42</li>") {
+		t.Error("For", "TestPieChartNonFinite", "expected only finite slices in total, got", v)
+	}
+}
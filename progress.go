@@ -0,0 +1,101 @@
+package embgui
+
+import (
+	"math"
+	"strings"
+)
+
+// textBarWidth is a number of characters in a text progress bar
+const textBarWidth = 10
+
+// progressRatio returns value's part of max clamped to <0, 1>, non-finite ratios are 0
+func progressRatio(value float64, max float64) float64 {
+	ratio := value / max
+	if max <= 0 || math.IsNaN(ratio) {
+		return 0
+	}
+	return math.Max(0, math.Min(1, ratio))
+}
+
+// textBar renders value as a text progress bar like "[#######---] 70%"
+func textBar(value float64, max float64) string {
+	ratio := progressRatio(value, max)
+	filled := int(math.Round(ratio * textBarWidth))
+	return "[" + strings.Repeat("#", filled) + strings.Repeat("-", textBarWidth-filled) + "] " +
+		fmtValue(math.Round(ratio*100)) + "%"
+}
+
+// Progress generates bulma's progress bar
+// color can be one of bulma's colors (see https://bulma.io/documentation/elements/progress/#colors)
+// text-based browsers show a text bar like "[#######---] 70%" instead
+//
+//		page.Progress(70, 100, "is-warning")
+func (n *EmbNode) Progress(value float64, max float64, color string) *EmbNode {
+	return n.add(&EmbNode{HTMLTag: "progress", Class: strings.TrimSpace("progress " + color),
+		Value: fmtValue(value), Max: fmtValue(max), Text: textBar(value, max)})
+}
+
+// color picks bulma's color for a value
+func (t Thresholds) color(value float64) string {
+	if t.HigherIsBetter {
+		switch {
+		case value <= t.Danger:
+			return "is-danger"
+		case value <= t.Warning:
+			return "is-warning"
+		}
+		return "is-success"
+	}
+	switch {
+	case value >= t.Danger:
+		return "is-danger"
+	case value >= t.Warning:
+		return "is-warning"
+	}
+	return "is-success"
+}
+
+// Gauge generates SVG gauge (a half-circle dial) with a value and a label below
+// the dial is colored with thresholds (see Thresholds), that are marked on the dial,
+// without thresholds it's blue (bulma's is-link)
+// text-based browsers get a text bar like "[#######---] 70%" instead
+//
+//		page.Gauge("CPU usage", 72, 100, &embgui.Thresholds{Warning: 70, Danger: 90})
+func (n *EmbNode) Gauge(label string, value float64, max float64, thresholds *Thresholds) *EmbNode {
+	cx, cy, r := 100.0, 100.0, 80.0
+	ratio := progressRatio(value, max)
+	// point returns position on the arc for a ratio, 0 is on the left, 1 on the right
+	point := func(ratio float64) string {
+		angle := math.Pi * (1 + ratio)
+		return fmtFloat(cx+r*math.Cos(angle)) + "," + fmtFloat(cy+r*math.Sin(angle))
+	}
+	arc := func(to float64) string {
+		return "M" + point(0) + " A" + fmtFloat(r) + "," + fmtFloat(r) + " 0 0,1 " + point(to)
+	}
+	s := newSVG(200, 140, label)
+	s.tag("path", "", "d", arc(1), "fill", "none", "stroke", gridColor, "stroke-width", "16")
+	if ratio > 0 {
+		color := bulmaColors["is-link"]
+		if thresholds != nil {
+			color = bulmaColors[thresholds.color(value)]
+		}
+		s.tag("path", "", "d", arc(ratio), "fill", "none", "stroke", color, "stroke-width", "16")
+	}
+	if thresholds != nil {
+		for _, th := range []float64{thresholds.Warning, thresholds.Danger} {
+			if th < 0 || max <= 0 || th > max {
+				continue
+			}
+			angle := math.Pi * (1 + th/max)
+			s.line(cx+(r-10)*math.Cos(angle), cy+(r-10)*math.Sin(angle), cx+(r+10)*math.Cos(angle), cy+(r+10)*math.Sin(angle), axisColor)
+		}
+	}
+	s.tag("text", fmtValue(math.Round(ratio*100))+"%", "x", fmtFloat(cx), "y", fmtFloat(cy-6), "text-anchor", "middle",
+		"font-size", "24", "font-weight", "bold", "fill", axisColor)
+	s.text(cx, cy+28, "middle", axisColor, label)
+
+	figure := n.add(&EmbNode{HTMLTag: "figure", Class: "embgui-gauge", Style: "max-width: 240px; display: inline-block",
+		Text: s.String(), Unsafe: true})
+	figure.textFallback().add(&EmbNode{HTMLTag: "p", Text: label + " " + textBar(value, max)})
+	return figure
+}
@@ -0,0 +1,71 @@
+package embgui
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestProgress(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	v := page.Progress(70, 100, "is-warning").render()
+	expectedResult := `<progress class='progress is-warning' value='70' max='100'>[#######---] 70%</progress>`
+	if v != expectedResult {
+		t.Error(
+			"For", "TestProgress",
+			"expected", expectedResult,
+			"got", v,
+		)
+	}
+	if v := textBar(150, 100); v != "[##########] 100%" {
+		t.Error("For", "TestProgress", "expected overflow to be clamped, got", v)
+	}
+	if v := textBar(math.NaN(), 100); v != "[----------] 0%" {
+		t.Error("For", "TestProgress", "expected NaN to be shown as 0, got", v)
+	}
+	if v := textBar(math.Inf(-1), math.Inf(1)); v != "[----------] 0%" {
+		t.Error("For", "TestProgress", "expected infinite ratio to be shown as 0, got", v)
+	}
+	if v := page.Gauge("cpu", math.NaN(), 100, &Thresholds{Warning: 70, Danger: 90}).render(); strings.Contains(v, "NaN%") {
+		t.Error("For", "TestProgress", "expected NaN gauge to be shown as 0, got", v)
+	}
+}
+
+func TestGauge(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	cpu := &Thresholds{Warning: 70, Danger: 90}
+	disk := &Thresholds{Warning: 10, Danger: 0, HigherIsBetter: true}
+	tests := []struct {
+		value      float64
+		thresholds *Thresholds
+		expected   string
+	}{
+		{50, cpu, "stroke='#23d160'"},
+		{75, cpu, "stroke='#ffdd57'"},
+		{95, cpu, "stroke='#ff3860'"},
+		{50, nil, "stroke='#3273dc'"},
+		{5, disk, "stroke='#ffdd57'"},
+		{60, disk, "stroke='#23d160'"},
+	}
+	for _, test := range tests {
+		v := page.Gauge("CPU usage", test.value, 100, test.thresholds).render()
+		testStrings := []string{test.expected,
+			`<figure class='embgui-gauge' style='max-width: 240px; display: inline-block'><svg`,
+			`<div class='is-hidden'><p>CPU usage [`}
+		for _, str := range testStrings {
+			if strings.Contains(v, str) == false {
+				t.Error(
+					"For", "TestGauge",
+					"expected to have", str,
+					"got", v,
+				)
+			}
+		}
+	}
+}
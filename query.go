@@ -0,0 +1,59 @@
+package embgui
+
+import (
+	"net/http"
+	"net/url"
+	"sort"
+)
+
+// queryLink returns a link to the current page with given query parameters replaced
+// parameters are given as name/value pairs, empty values remove parameters
+// other query parameters are kept, so links play well with filters, sorting and pagination
+func queryLink(r *http.Request, pairs ...string) string {
+	u := url.URL{}
+	query := url.Values{}
+	if r != nil && r.URL != nil {
+		u.Path = r.URL.Path
+		query = r.URL.Query()
+	}
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if pairs[i+1] == "" {
+			query.Del(pairs[i])
+		} else {
+			query.Set(pairs[i], pairs[i+1])
+		}
+	}
+	u.RawQuery = query.Encode()
+	if u.Path == "" && u.RawQuery == "" {
+		return "?"
+	}
+	return u.String()
+}
+
+// queryParam returns query parameter of a request, it's safe to use with nil request
+func queryParam(r *http.Request, name string) string {
+	if r == nil || r.URL == nil {
+		return ""
+	}
+	return r.URL.Query().Get(name)
+}
+
+// keepQuery points GET form at the current page and keeps its query parameters with hidden inputs
+// GET form drops query string of its action, parameters submitted by the form itself should be skipped
+func (n *EmbNode) keepQuery(r *http.Request, skip map[string]bool) {
+	if r == nil || r.URL == nil {
+		return
+	}
+	n.Action = r.URL.Path
+	query := r.URL.Query()
+	var names []string
+	for name := range query {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if !skip[name] {
+			n.add(&EmbNode{HTMLTag: "input", Type: "hidden", Name: name, Value: query.Get(name)})
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package embgui
+
+import (
+	"math"
+	"strings"
+)
+
+const (
+	// sparklineWidth is a width of a sparkline in pixels
+	sparklineWidth = 120
+	// sparklineHeight is a height of a sparkline in pixels
+	sparklineHeight = 24
+)
+
+// sparkBlocks are used to draw sparklines in text-based browsers
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// Sparkline generates tiny inline SVG line chart, min and max values are marked with
+// bulma's danger and success colors, the last value is marked with a dark dot
+// NaN and infinite values are missing samples, the line breaks at them
+// text-based browsers get a line of unicode block characters instead
+// it fits into table cells and tiles (see Tile.Trend)
+//
+//		row.Td("").Sparkline([]float64{3, 5, 2, 8, 7})
+func (n *EmbNode) Sparkline(values []float64) *EmbNode {
+	span := n.add(&EmbNode{HTMLTag: "span", Class: "embgui-sparkline", Unsafe: true})
+	minIdx, maxIdx, lastIdx := -1, -1, -1
+	for i, v := range values {
+		if !finite(v) {
+			continue
+		}
+		if minIdx < 0 || v < values[minIdx] {
+			minIdx = i
+		}
+		if maxIdx < 0 || v > values[maxIdx] {
+			maxIdx = i
+		}
+		lastIdx = i
+	}
+	if lastIdx < 0 {
+		return span
+	}
+	lo, hi := values[minIdx], values[maxIdx]
+	p := &xyPlot{left: 3, top: 3, right: sparklineWidth - 3, bottom: sparklineHeight - 3,
+		xMin: 0, xMax: float64(len(values) - 1), yMin: lo, yMax: hi}
+	if lo == hi {
+		p.yMin, p.yMax = lo-1, hi+1
+	}
+	s := newInlineSVG(sparklineWidth, sparklineHeight)
+	s.tag("title", "min "+fmtValue(lo)+", max "+fmtValue(hi)+", last "+fmtValue(values[lastIdx]))
+	var segment []string
+	// flush draws points collected since the last gap
+	flush := func() {
+		if len(segment) > 1 {
+			s.tag("polyline", "", "points", strings.Join(segment, " "), "fill", "none", "stroke", bulmaColors["is-link"], "stroke-width", "1.5")
+		} else if len(segment) == 1 {
+			xy := strings.Split(segment[0], ",")
+			s.tag("circle", "", "cx", xy[0], "cy", xy[1], "r", "1", "fill", bulmaColors["is-link"])
+		}
+		segment = nil
+	}
+	for i, v := range values {
+		if !finite(v) {
+			flush()
+			continue
+		}
+		segment = append(segment, fmtFloat(p.x(float64(i)))+","+fmtFloat(p.y(v)))
+	}
+	flush()
+	dot := func(i int, color string) {
+		s.tag("circle", "", "cx", fmtFloat(p.x(float64(i))), "cy", fmtFloat(p.y(values[i])), "r", "2", "fill", color)
+	}
+	dot(minIdx, bulmaColors["is-danger"])
+	dot(maxIdx, bulmaColors["is-success"])
+	dot(lastIdx, bulmaColors["is-dark"])
+	span.Text = s.String()
+	span.add(&EmbNode{HTMLTag: "span", Class: "is-hidden", Text: sparkText(values)})
+	return span
+}
+
+// sparkText renders values as a line of unicode block characters, one character per value
+// missing (NaN and infinite) values are shown as spaces
+func sparkText(values []float64) string {
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, v := range values {
+		if finite(v) {
+			lo, hi = minFloat(lo, v), maxFloat(hi, v)
+		}
+	}
+	var buffer strings.Builder
+	for _, v := range values {
+		if !finite(v) {
+			buffer.WriteRune(' ')
+			continue
+		}
+		level := len(sparkBlocks) / 2
+		if hi > lo {
+			level = int(math.Round((v - lo) / (hi - lo) * float64(len(sparkBlocks)-1)))
+		}
+		buffer.WriteRune(sparkBlocks[level])
+	}
+	return buffer.String()
+}
@@ -0,0 +1,82 @@
+package embgui
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestSparkText(t *testing.T) {
+	tests := []struct {
+		values   []float64
+		expected string
+	}{
+		{[]float64{1, 2, 3, 4, 5, 6, 7, 8}, "▁▂▃▄▅▆▇█"},
+		{[]float64{0, 10, 5}, "▁█▅"},
+		{[]float64{3, 3}, "▅▅"},
+		{nil, ""},
+		{[]float64{math.NaN(), 1, 5}, " ▁█"},
+		{[]float64{1, math.Inf(1)}, "▅ "},
+	}
+	for _, test := range tests {
+		v := sparkText(test.values)
+		if v != test.expected {
+			t.Error(
+				"For", "TestSparkText", test.values,
+				"expected", test.expected,
+				"got", v,
+			)
+		}
+	}
+}
+
+func TestSparkline(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	row := page.GenTableBody([]string{"cpu"}).Tr()
+	v := row.Td("").Sparkline([]float64{3, 5, 2, 8, 7}).render()
+	testStrings := []string{`<span class='embgui-sparkline'><svg xmlns='http://www.w3.org/2000/svg' viewBox='0 0 120 24' width='120' height='24'`,
+		`<title>min 2, max 8, last 7</title>`,
+		`<circle cx='60' cy='21' r='2' fill='#ff3860'/>`,
+		`<circle cx='88.5' cy='3' r='2' fill='#23d160'/>`,
+		`<circle cx='117' cy='6' r='2' fill='#363636'/>`,
+		`<span class='is-hidden'>▂▅▁█▇</span></span>`}
+	for _, str := range testStrings {
+		if strings.Contains(v, str) == false {
+			t.Error(
+				"For", "TestSparkline",
+				"expected to have", str,
+				"got", v,
+			)
+		}
+	}
+	tiles := page.GenTiles(Tile{Title: "90%", Subtitle: "CPU usage", Trend: []float64{70, 90}}).render()
+	if strings.Contains(tiles, `<p class='subtitle'>CPU usage</p><p><span class='embgui-sparkline'><svg`) == false {
+		t.Error("For", "TestSparkline", "expected sparkline in a tile, got", tiles)
+	}
+}
+
+func TestSparklineNonFinite(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	nan, inf := math.NaN(), math.Inf(1)
+	tests := [][]float64{{nan, 1, 5}, {1, inf}, {1, 2, nan, 3, 4, -inf}, {nan, nan}}
+	for _, values := range tests {
+		v := page.Sparkline(values).render()
+		if strings.Contains(v, "NaN") || strings.Contains(v, "Inf") {
+			t.Error("For", "TestSparklineNonFinite", values, "expected no non-finite coordinates", "got", v)
+		}
+	}
+	v := page.Sparkline([]float64{1, 2, nan, 3, 4, -inf}).render()
+	if strings.Count(v, "<polyline ") != 2 || !strings.Contains(v, "<title>min 1, max 4, last 4</title>") {
+		t.Error("For", "TestSparklineNonFinite", "expected line broken at missing values", "got", v)
+	}
+	tiles := page.GenTiles(Tile{Title: "5", Subtitle: "queue", Trend: []float64{nan, 1, 5}}).render()
+	if !strings.Contains(tiles, `<span class='is-hidden'> ▁█</span>`) {
+		t.Error("For", "TestSparklineNonFinite", "expected trend with a missing sample in a tile, got", tiles)
+	}
+}
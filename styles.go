@@ -0,0 +1,43 @@
+package embgui
+
+// styles is a small stylesheet for components that bulma doesn't cover
+// it's inlined into every page by RenderPage()
+const styles = `
+.modal:target{display:flex}
+.embgui-collapsible{border:1px solid #dbdbdb;border-radius:4px;margin-bottom:.75rem}
+.embgui-collapsible>summary{background:#f5f5f5;cursor:pointer;font-weight:600;padding:.5em .75em}
+.embgui-collapsible[open]>summary{border-bottom:1px solid #dbdbdb}
+.embgui-collapsible-content{padding:.75em}
+.embgui-accordion{margin-bottom:1.5rem}
+.embgui-accordion>.embgui-collapsible{border-radius:0;margin-bottom:0}
+.embgui-accordion>.embgui-collapsible+.embgui-collapsible{border-top:0}
+.embgui-kv{display:grid;grid-template-columns:minmax(8em,max-content) auto;gap:.25em 1.5em;margin-bottom:1.5rem}
+.embgui-kv>dt{font-weight:600}
+.embgui-kv>dd{margin:0;overflow-wrap:anywhere}
+.embgui-kv .embgui-kv{border-left:2px solid #dbdbdb;margin-bottom:0;padding-left:1em}
+.embgui-tree{font-family:monospace;margin-bottom:1.5rem;overflow-wrap:anywhere}
+.embgui-tree summary{cursor:pointer}
+.embgui-tree ul{border-left:1px dotted #b5b5b5;margin-left:.3em;padding-left:1.2em}
+.embgui-diff{font-family:monospace}
+.embgui-diff td{border:0;overflow-wrap:anywhere;white-space:pre-wrap}
+.embgui-diff td.embgui-diff-num{color:#7a7a7a;text-align:right;user-select:none;white-space:nowrap;width:1%}
+.embgui-diff .embgui-diff-del{background:#ffebef}
+.embgui-diff .embgui-diff-add{background:#e6fbee}
+.embgui-diff .embgui-diff-empty,.embgui-diff-skip td{background:#f5f5f5}
+.embgui-diff-skip td{color:#7a7a7a;text-align:center}
+.embgui-log{font-family:monospace;margin-bottom:1.5rem}
+.embgui-log-entry{border-bottom:1px solid #f5f5f5;overflow-wrap:anywhere;padding:.15em 0;white-space:pre-wrap}
+.embgui-log-entry>*{margin-right:.75em}
+.embgui-log-entry>.tag{min-width:4.5em}
+pre.embgui-code{padding:1em 0}
+.embgui-code-line{display:block;padding:0 1.25em}
+.embgui-code-line.is-highlighted{background:#fff6d9}
+.embgui-code-num{color:#b5b5b5;margin-right:1em;user-select:none}
+.embgui-kw{color:#3273dc;font-weight:600}
+.embgui-str{color:#23854a}
+.embgui-num{color:#8c4dcc}
+.embgui-com{color:#7a7a7a;font-style:italic}
+.embgui-key{color:#c4244a}
+.embgui-lit{color:#1d72aa}
+.embgui-var{color:#946c00}
+`
@@ -0,0 +1,266 @@
+package embgui
+
+import (
+	"fmt"
+	"html"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// palette is a list of bulma's colors used to paint chart series, in order
+// (see https://bulma.io/documentation/overview/colors/)
+var palette = []string{"#3273dc", "#ff3860", "#23d160", "#ffdd57", "#209cee", "#00d1b2", "#363636"}
+
+// bulmaColors maps bulma's color modifiers to their hex values
+var bulmaColors = map[string]string{
+	"is-primary": "#00d1b2",
+	"is-link":    "#3273dc",
+	"is-info":    "#209cee",
+	"is-success": "#23d160",
+	"is-warning": "#ffdd57",
+	"is-danger":  "#ff3860",
+	"is-dark":    "#363636",
+	"is-light":   "#f5f5f5",
+	"is-white":   "#ffffff",
+	"is-black":   "#0a0a0a",
+}
+
+const (
+	// axisColor is used for chart axes and labels
+	axisColor = "#4a4a4a"
+	// gridColor is used for chart grid lines
+	gridColor = "#dbdbdb"
+)
+
+// seriesColor returns palette color for the i-th series
+func seriesColor(i int) string {
+	return palette[i%len(palette)]
+}
+
+// colorValue returns hex value of a bulma's color modifier, or the color itself
+// so both "is-danger" and "#ff0000" may be used
+func colorValue(color string, fallback string) string {
+	if color == "" {
+		return fallback
+	}
+	if hex, ok := bulmaColors[color]; ok {
+		return hex
+	}
+	return color
+}
+
+// mixColor blends white with a hex color, ratio 0 gives white and 1 gives the color
+func mixColor(color string, ratio float64) string {
+	ratio = math.Max(0, math.Min(1, ratio))
+	rgb, err := strconv.ParseUint(strings.TrimPrefix(color, "#"), 16, 32)
+	if err != nil || len(color) != 7 {
+		return color
+	}
+	mix := func(c uint64) int {
+		return int(math.Round(255 - (255-float64(c))*ratio))
+	}
+	return fmt.Sprintf("#%02x%02x%02x", mix(rgb>>16&0xff), mix(rgb>>8&0xff), mix(rgb&0xff))
+}
+
+// fmtFloat formats number for SVG attributes
+// it keeps two decimal places at most, so the output is short and deterministic
+func fmtFloat(v float64) string {
+	s := strconv.FormatFloat(v, 'f', 2, 64)
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	if s == "-0" {
+		return "0"
+	}
+	return s
+}
+
+// niceNum finds a "nice" number (1, 2, 5 * 10^n) approximately equal to x
+// it rounds the number if round is true, takes ceiling otherwise
+// (see Paul Heckbert, "Nice Numbers for Graph Labels", Graphics Gems)
+func niceNum(x float64, round bool) float64 {
+	if x <= 0 {
+		return 1
+	}
+	exp := math.Floor(math.Log10(x))
+	f := x / math.Pow(10, exp)
+	var nf float64
+	if round {
+		switch {
+		case f < 1.5:
+			nf = 1
+		case f < 3:
+			nf = 2
+		case f < 7:
+			nf = 5
+		default:
+			nf = 10
+		}
+	} else {
+		switch {
+		case f <= 1:
+			nf = 1
+		case f <= 2:
+			nf = 2
+		case f <= 5:
+			nf = 5
+		default:
+			nf = 10
+		}
+	}
+	return nf * math.Pow(10, exp)
+}
+
+// finite reports whether v is neither NaN nor infinity
+func finite(v float64) bool {
+	return !math.IsNaN(v) && !math.IsInf(v, 0)
+}
+
+// niceTicks returns evenly spaced, "nice" tick values covering <min, max>
+// count is an approximate number of ticks, at least two ticks are always returned
+// non-finite bounds are replaced with <0, 1>
+func niceTicks(min float64, max float64, count int) []float64 {
+	if count < 2 {
+		count = 2
+	}
+	if !finite(min) || !finite(max) {
+		min, max = 0, 1
+	}
+	if min == max {
+		d := maxFloat(1, math.Abs(min)/10)
+		min, max = min-d, max+d
+	}
+	if min > max {
+		min, max = max, min
+	}
+	step := niceNum(niceNum(max-min, false)/float64(count-1), true)
+	if !finite(step) || step <= 0 {
+		return []float64{min, max}
+	}
+	lo := math.Floor(min/step) * step
+	hi := math.Ceil(max/step) * step
+	decimals := tickDecimals(step)
+	var ticks []float64
+	for i := 0; lo+float64(i)*step <= hi+step/2; i++ {
+		v, _ := strconv.ParseFloat(strconv.FormatFloat(lo+float64(i)*step, 'f', decimals, 64), 64)
+		ticks = append(ticks, v)
+	}
+	if len(ticks) < 2 {
+		return []float64{min, max}
+	}
+	return ticks
+}
+
+// tickDecimals returns number of decimal places required to show ticks with a given step
+func tickDecimals(step float64) int {
+	if step <= 0 || step >= 1 {
+		return 0
+	}
+	return int(math.Ceil(-math.Log10(step) - 1e-9))
+}
+
+// fmtTick formats a tick label with precision required by step
+func fmtTick(v float64, step float64) string {
+	s := strconv.FormatFloat(v, 'f', tickDecimals(step), 64)
+	if s == "-0" {
+		return "0"
+	}
+	return s
+}
+
+// svgCanvas helps building inline SVG markup
+type svgCanvas struct {
+	buffer strings.Builder
+}
+
+// newSVG starts a new SVG image with a given view box
+// the image scales to the width of its container
+func newSVG(width int, height int, title string) *svgCanvas {
+	s := &svgCanvas{}
+	s.buffer.WriteString("<svg xmlns='http://www.w3.org/2000/svg'")
+	attr("viewBox", "0 0 "+strconv.Itoa(width)+" "+strconv.Itoa(height), &s.buffer)
+	attr("width", "100%", &s.buffer)
+	attr("role", "img", &s.buffer)
+	attr("font-family", "sans-serif", &s.buffer)
+	attr("font-size", "11", &s.buffer)
+	s.buffer.WriteString(">")
+	if title != "" {
+		s.tag("title", title)
+	}
+	return s
+}
+
+// newInlineSVG starts a small SVG image with a fixed size, it's meant to be placed inside text
+func newInlineSVG(width int, height int) *svgCanvas {
+	s := &svgCanvas{}
+	s.buffer.WriteString("<svg xmlns='http://www.w3.org/2000/svg'")
+	attr("viewBox", "0 0 "+strconv.Itoa(width)+" "+strconv.Itoa(height), &s.buffer)
+	attr("width", strconv.Itoa(width), &s.buffer)
+	attr("height", strconv.Itoa(height), &s.buffer)
+	attr("style", "vertical-align: middle", &s.buffer)
+	s.buffer.WriteString(">")
+	return s
+}
+
+// tag writes SVG element with given text and attributes given as name/value pairs
+// attributes with empty values are skipped
+func (s *svgCanvas) tag(name string, text string, attrs ...string) {
+	s.buffer.WriteString("<")
+	s.buffer.WriteString(name)
+	for i := 0; i+1 < len(attrs); i += 2 {
+		attr(attrs[i], attrs[i+1], &s.buffer)
+	}
+	if text == "" {
+		s.buffer.WriteString("/>")
+		return
+	}
+	s.buffer.WriteString(">")
+	s.buffer.WriteString(html.EscapeString(text))
+	s.buffer.WriteString("</")
+	s.buffer.WriteString(name)
+	s.buffer.WriteString(">")
+}
+
+// open starts SVG container element (like <g>), it needs to be closed with end()
+func (s *svgCanvas) open(name string, attrs ...string) {
+	s.buffer.WriteString("<")
+	s.buffer.WriteString(name)
+	for i := 0; i+1 < len(attrs); i += 2 {
+		attr(attrs[i], attrs[i+1], &s.buffer)
+	}
+	s.buffer.WriteString(">")
+}
+
+// end closes SVG container element
+func (s *svgCanvas) end(name string) {
+	s.buffer.WriteString("</")
+	s.buffer.WriteString(name)
+	s.buffer.WriteString(">")
+}
+
+// line draws a line
+func (s *svgCanvas) line(x1, y1, x2, y2 float64, stroke string) {
+	s.tag("line", "", "x1", fmtFloat(x1), "y1", fmtFloat(y1), "x2", fmtFloat(x2), "y2", fmtFloat(y2), "stroke", stroke)
+}
+
+// rect draws a filled rectangle, tooltip is optional
+func (s *svgCanvas) rect(x, y, w, h float64, fill string, tooltip string) {
+	if tooltip == "" {
+		s.tag("rect", "", "x", fmtFloat(x), "y", fmtFloat(y), "width", fmtFloat(w), "height", fmtFloat(h), "fill", fill)
+		return
+	}
+	s.open("rect", "x", fmtFloat(x), "y", fmtFloat(y), "width", fmtFloat(w), "height", fmtFloat(h), "fill", fill)
+	s.tag("title", tooltip)
+	s.end("rect")
+}
+
+// text writes a label, anchor is one of start, middle, end
+func (s *svgCanvas) text(x, y float64, anchor string, fill string, text string) {
+	s.tag("text", text, "x", fmtFloat(x), "y", fmtFloat(y), "text-anchor", anchor, "fill", fill)
+}
+
+// String returns complete SVG markup
+func (s *svgCanvas) String() string {
+	return s.buffer.String() + "</svg>"
+}
@@ -0,0 +1,290 @@
+package embgui
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/inteliwise/embgui/format"
+)
+
+// RowAction is a button in a per-row action column
+// Link generates GET link (see MiniLinkButton()), Action generates POST button (see MiniActionButton())
+// and Delete turns POST button into DEL button (see MiniDelButton())
+// actions with empty Text are skipped
+type RowAction struct {
+	Text   string
+	Link   string
+	Action string
+	Delete bool
+}
+
+// TableOptions customizes TableFromSlice
+// every function in Actions is called for every row and may return a button
+type TableOptions struct {
+	Actions       []func(item interface{}) RowAction
+	ActionsHeader string
+}
+
+// tableColumn is a column read from a struct field
+type tableColumn struct {
+	header string
+	index  []int
+	format string
+	hidden bool
+	secret bool
+	cut    bool
+}
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	stringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+)
+
+// fieldTag is a parsed `embgui:"Name,fmt=bytes,hide,secret"` struct tag
+type fieldTag struct {
+	name   string
+	format string
+	hidden bool
+	secret bool
+}
+
+// redacted replaces values of fields tagged as secret
+const redacted = "********"
+
+// parseTableTag parses `embgui:"Name,fmt=bytes,hide,secret"` tag
+func parseTableTag(tag string) fieldTag {
+	parts := strings.Split(tag, ",")
+	ft := fieldTag{name: strings.TrimSpace(parts[0])}
+	for _, part := range parts[1:] {
+		part = strings.TrimSpace(part)
+		switch {
+		case part == "hide":
+			ft.hidden = true
+		case part == "secret":
+			ft.secret = true
+		case strings.HasPrefix(part, "fmt="):
+			ft.format = strings.TrimPrefix(part, "fmt=")
+		}
+	}
+	return ft
+}
+
+// isLeaf tells if a field is shown as a single cell, or nested struct that is expanded into columns
+func isLeaf(t reflect.Type) bool {
+	if t == timeType || t.Implements(stringerType) {
+		return true
+	}
+	if t.Kind() == reflect.Ptr {
+		return isLeaf(t.Elem()) || t.Elem().Kind() != reflect.Struct
+	}
+	return t.Kind() != reflect.Struct
+}
+
+// structType returns a struct type behind pointers
+func structType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
+
+// tableColumns lists columns of a struct type, nested structs are expanded with prefixed headers
+// path holds struct types being expanded, a type that refers to itself (like a parent pointer) is shown as an empty cell,
+// so fields of the referenced struct (secret ones too) aren't printed into it
+func tableColumns(t reflect.Type, prefix string, index []int, path map[reflect.Type]bool) []tableColumn {
+	t = structType(t)
+	path[t] = true
+	defer delete(path, t)
+	var columns []tableColumn
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" && !field.Anonymous {
+			continue
+		}
+		tag := field.Tag.Get("embgui")
+		if tag == "-" {
+			continue
+		}
+		ft := parseTableTag(tag)
+		name := ft.name
+		if name == "" {
+			name = field.Name
+		}
+		fieldIndex := append(append([]int{}, index...), i)
+		if !isLeaf(field.Type) && !path[structType(field.Type)] {
+			nestedPrefix := prefix + name + " "
+			if field.Anonymous && tag == "" {
+				nestedPrefix = prefix
+			}
+			for _, c := range tableColumns(field.Type, nestedPrefix, fieldIndex, path) {
+				c.hidden = c.hidden || ft.hidden
+				c.secret = c.secret || ft.secret
+				columns = append(columns, c)
+			}
+			continue
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+		columns = append(columns, tableColumn{header: prefix + name, index: fieldIndex, format: ft.format,
+			hidden: ft.hidden, secret: ft.secret, cut: !isLeaf(field.Type)})
+	}
+	return columns
+}
+
+// value reads column's value from a struct, it returns nil if there's a nil pointer on the way
+func (c tableColumn) value(v reflect.Value) interface{} {
+	for _, i := range c.index {
+		for v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return nil
+			}
+			v = v.Elem()
+		}
+		v = v.Field(i)
+	}
+	if v.Kind() == reflect.Ptr && v.IsNil() {
+		return nil
+	}
+	return v.Interface()
+}
+
+// formatCell formats a value using fmt option from a struct tag
+// supported options: bytes, iec, si, percent, rate, relative, date, datetime and fmt verbs like %.2f
+func formatCell(f format.Formatter, v interface{}, spec string) string {
+	if v == nil {
+		return ""
+	}
+	value := reflect.ValueOf(v)
+	if value.Kind() == reflect.Ptr && !value.Type().Implements(stringerType) {
+		v = value.Elem().Interface()
+	}
+	if strings.HasPrefix(spec, "%") {
+		return fmt.Sprintf(spec, v)
+	}
+	if t, ok := v.(time.Time); ok {
+		switch {
+		case t.IsZero():
+			return ""
+		case spec == "relative":
+			return f.Relative(t)
+		case spec == "date":
+			return t.Format("2006-01-02")
+		}
+		return t.Format("2006-01-02 15:04:05")
+	}
+	number, ok := numericValue(v)
+	if !ok {
+		return f.Format(v)
+	}
+	switch spec {
+	case "bytes":
+		return f.Bytes(int64(number))
+	case "iec":
+		return f.IECBytes(int64(number))
+	case "si":
+		return f.SI(number)
+	case "percent":
+		return f.Percent(number, 1)
+	case "rate":
+		return f.Rate(number)
+	}
+	return f.Format(v)
+}
+
+// redact hides a value of a secret field, empty values are left empty, so it's visible that they're not set
+func redact(v interface{}) string {
+	if v == nil || reflect.ValueOf(v).IsZero() {
+		return ""
+	}
+	return redacted
+}
+
+// sliceValue checks that data is a slice or an array of structs (or pointers to structs)
+func sliceValue(data interface{}) (reflect.Value, reflect.Type, error) {
+	v := reflect.ValueOf(data)
+	for v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return v, nil, errors.New("can't generate table from non-slice value")
+	}
+	t := v.Type().Elem()
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return v, nil, errors.New("can't generate table from slice of non-struct values")
+	}
+	return v, t, nil
+}
+
+// TableFromSlice generates a table from a slice of structs (or pointers to structs) via reflection
+// headers and formatting are read from struct tags: `embgui:"Name,fmt=bytes,hide"`, secret option redacts values,
+// `embgui:"-"` skips a field, nested structs are expanded into columns prefixed with the field's name
+// time.Time, fmt.Stringer and pointers are supported, nil pointers are shown as empty cells
+// see formatCell() for fmt options, other values are formatted with page's formatter (see format package)
+// it returns tbody, just like GenTableBody()
+//
+//		type Disk struct {
+//			Mount string    `embgui:"Mount point"`
+//			Size  int64     `embgui:"Size,fmt=iec"`
+//			Seen  time.Time `embgui:"Last check,fmt=relative"`
+//		}
+//		page.TableFromSlice(disks, embgui.TableOptions{Actions: []func(interface{}) embgui.RowAction{
+//			func(item interface{}) embgui.RowAction {
+//				return embgui.RowAction{Text: "Inspect", Link: "/disks/" + item.(Disk).Mount}
+//			}}})
+func (n *EmbNode) TableFromSlice(data interface{}, opts TableOptions) (*EmbNode, error) {
+	rows, t, err := sliceValue(data)
+	if err != nil {
+		return nil, err
+	}
+	columns := tableColumns(t, "", nil, map[reflect.Type]bool{})
+	var header []string
+	for _, c := range columns {
+		if !c.hidden {
+			header = append(header, c.header)
+		}
+	}
+	if len(opts.Actions) > 0 {
+		header = append(header, opts.ActionsHeader)
+	}
+	tbody := n.GenTableBody(header)
+	f := n.formatter()
+	for i := 0; i < rows.Len(); i++ {
+		item := rows.Index(i)
+		row := tbody.Tr()
+		for _, c := range columns {
+			switch {
+			case c.hidden:
+			case c.secret:
+				row.Td(redact(c.value(item)))
+			case c.cut:
+				row.Td("")
+			default:
+				row.Td(formatCell(f, c.value(item), c.format))
+			}
+		}
+		if len(opts.Actions) == 0 {
+			continue
+		}
+		buttons := row.Td("").Buttons()
+		for _, action := range opts.Actions {
+			a := action(item.Interface())
+			switch {
+			case a.Text == "":
+			case a.Delete:
+				buttons.MiniDelButton(a.Text, a.Action)
+			case a.Action != "":
+				buttons.MiniActionButton(a.Text, a.Action)
+			default:
+				buttons.MiniLinkButton(a.Text, a.Link)
+			}
+		}
+	}
+	return tbody, nil
+}
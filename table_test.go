@@ -0,0 +1,111 @@
+package embgui
+
+import (
+	"testing"
+	"time"
+)
+
+type testOwner struct {
+	Name  string
+	Email string `embgui:"-"`
+}
+
+type testState int
+
+func (s testState) String() string {
+	if s == 1 {
+		return "running"
+	}
+	return "stopped"
+}
+
+type testDisk struct {
+	ID      int
+	Mount   string    `embgui:"Mount point"`
+	Size    int64     `embgui:"Size,fmt=iec"`
+	Usage   float64   `embgui:"Usage,fmt=%.0f%%"`
+	Checked time.Time `embgui:"Checked,fmt=date"`
+	State   testState
+	Owner   *testOwner
+	Secret  string `embgui:"Secret,hide"`
+	private string
+}
+
+func TestTableFromSlice(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	disks := []testDisk{
+		{ID: 1, Mount: "/", Size: 1536, Usage: 42.4, Checked: time.Date(2020, 3, 10, 12, 0, 0, 0, time.UTC),
+			State: 1, Owner: &testOwner{Name: "root"}, Secret: "x", private: "y"},
+		{ID: 1200, Mount: "/var", Size: 5 << 30},
+	}
+	tbody, err := page.TableFromSlice(disks, TableOptions{ActionsHeader: "action", Actions: []func(interface{}) RowAction{
+		func(item interface{}) RowAction {
+			return RowAction{Text: "Inspect", Link: "/disks?mount=" + item.(testDisk).Mount}
+		},
+		func(item interface{}) RowAction {
+			if item.(testDisk).ID != 1 {
+				return RowAction{}
+			}
+			return RowAction{Text: "Remove", Action: "/disks/1", Delete: true}
+		},
+	}})
+	if err != nil {
+		t.Error("For", "TestTableFromSlice", "Error:", err.Error())
+	}
+	v := page.render()
+	expectedResult := `<><table class='table is-narrow is-hoverable is-fullwidth'><thead><tr><th>ID</th><th>Mount point</th>` +
+		`<th>Size</th><th>Usage</th><th>Checked</th><th>State</th><th>Owner Name</th><th>action</th></tr></thead><tbody>` +
+		`<tr><td>1</td><td>/</td><td>1.5 KiB</td><td>42%</td><td>2020-03-10</td><td>running</td><td>root</td>` +
+		`<td><div class='buttons'><a class='button is-link is-small' href='/disks?mount=/' style='margin: .25rem'>Inspect</a>` +
+		`<form action='/disks/1' method='POST'><input type='hidden' name='_method' value='DELETE'></input>` +
+		`<button class='button is-danger is-small' type='submit' style='margin: .25rem'>Remove</button></form></div></td></tr>` +
+		`<tr><td>1,200</td><td>/var</td><td>5 GiB</td><td>0%</td><td></td><td>stopped</td><td></td>` +
+		`<td><div class='buttons'><a class='button is-link is-small' href='/disks?mount=/var' style='margin: .25rem'>Inspect</a>` +
+		`</div></td></tr></tbody></table></>`
+	if v != expectedResult {
+		t.Error(
+			"For", "TestTableFromSlice",
+			"expected", expectedResult,
+			"got", v,
+		)
+	}
+	if tbody.HTMLTag != "tbody" {
+		t.Error("For", "TestTableFromSlice", "expected tbody, got", tbody.HTMLTag)
+	}
+	if _, err := page.TableFromSlice([]int{1, 2}, TableOptions{}); err == nil {
+		t.Error("For", "TestTableFromSlice", "expected error for slice of non-structs")
+	}
+}
+
+type testNode struct {
+	Name   string
+	Token  string `embgui:"Token,secret"`
+	Parent *testNode
+	Owner  testOwner
+}
+
+func TestTableFromSliceRecursive(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	root := &testNode{Name: "root", Token: "hunter2"}
+	_, err := page.TableFromSlice([]testNode{*root, {Name: "child", Parent: root, Owner: testOwner{Name: "ops"}}}, TableOptions{})
+	if err != nil {
+		t.Error("For", "TestTableFromSliceRecursive", "Error:", err.Error())
+	}
+	v := page.render()
+	expectedResult := `<><table class='table is-narrow is-hoverable is-fullwidth'><thead><tr><th>Name</th><th>Token</th><th>Parent</th>` +
+		`<th>Owner Name</th></tr></thead><tbody><tr><td>root</td><td>********</td><td></td><td></td></tr>` +
+		`<tr><td>child</td><td></td><td></td><td>ops</td></tr></tbody></table></>`
+	if v != expectedResult {
+		t.Error(
+			"For", "TestTableFromSliceRecursive",
+			"expected", expectedResult,
+			"got", v,
+		)
+	}
+}
@@ -0,0 +1,70 @@
+package embgui
+
+import (
+	"net/http"
+)
+
+// Tab is a single tab of Tabs component
+// Key identifies the tab in a query parameter and Name is displayed
+// Link is optional, it's used instead of a query parameter (e.g. for tabs that are separate URL paths)
+// Content is optional, it's called only for the active tab, so hidden tabs cost nothing
+// see Tabs()
+type Tab struct {
+	Key     string
+	Name    string
+	Link    string
+	Content func(content *EmbNode)
+}
+
+// ActiveTab returns the key of an active tab
+// it's taken from a query parameter, then from URL path matching tab's Link, the first tab is the default
+func ActiveTab(r *http.Request, param string, tabs ...Tab) string {
+	if len(tabs) == 0 {
+		return ""
+	}
+	if key := queryParam(r, param); key != "" {
+		for _, tab := range tabs {
+			if tab.Key == key {
+				return key
+			}
+		}
+	}
+	if r != nil && r.URL != nil {
+		for _, tab := range tabs {
+			if tab.Link != "" && tab.Link == r.URL.Path {
+				return tab.Key
+			}
+		}
+	}
+	return tabs[0].Key
+}
+
+// Tabs generates bulma's tabs, each tab is a link, so it works without JavaScript
+// it returns a div below the tabs, filled with the active tab's Content
+// the active tab comes from param query parameter or URL path (see ActiveTab())
+//
+//		content := page.Tabs(r, "tab",
+//		embgui.Tab{Key: "stats", Name: "Stats", Content: func(c *embgui.EmbNode) { c.P("stats") }},
+//		embgui.Tab{Key: "logs", Name: "Logs", Content: buildExpensiveLogs})
+func (n *EmbNode) Tabs(r *http.Request, param string, tabs ...Tab) *EmbNode {
+	active := ActiveTab(r, param, tabs...)
+	list := n.add(&EmbNode{HTMLTag: "div", Class: "tabs"}).add(&EmbNode{HTMLTag: "ul"})
+	for _, tab := range tabs {
+		item := list.add(&EmbNode{HTMLTag: "li"})
+		if tab.Key == active {
+			item.Class = "is-active"
+		}
+		link := tab.Link
+		if link == "" {
+			link = queryLink(r, param, tab.Key)
+		}
+		item.add(&EmbNode{HTMLTag: "a", Href: link, Text: tab.Name})
+	}
+	content := n.add(&EmbNode{HTMLTag: "div", Class: "embgui-tab-content"})
+	for _, tab := range tabs {
+		if tab.Key == active && tab.Content != nil {
+			tab.Content(content)
+		}
+	}
+	return content
+}
@@ -0,0 +1,41 @@
+package embgui
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTabs(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	built := []string{}
+	tabs := []Tab{
+		{Key: "stats", Name: "Stats", Content: func(c *EmbNode) { built = append(built, "stats"); c.P("stats") }},
+		{Key: "logs", Name: "Logs", Content: func(c *EmbNode) { built = append(built, "logs"); c.P("logs") }},
+		{Key: "docs", Name: "Docs", Link: "/docs"},
+	}
+	r := httptest.NewRequest("GET", "/status?tab=logs&page=2", nil)
+	content := page.Tabs(r, "tab", tabs...)
+	v := page.render()
+	expectedResult := `<><div class='tabs'><ul><li><a href='/status?page=2&amp;tab=stats'>Stats</a></li>` +
+		`<li class='is-active'><a href='/status?page=2&amp;tab=logs'>Logs</a></li>` +
+		`<li><a href='/docs'>Docs</a></li></ul></div><div class='embgui-tab-content'><p>logs</p></div></>`
+	if v != expectedResult {
+		t.Error(
+			"For", "TestTabs",
+			"expected", expectedResult,
+			"got", v,
+		)
+	}
+	if len(built) != 1 || content.Class != "embgui-tab-content" {
+		t.Error("For", "TestTabs", "expected only active tab to be built, got", built)
+	}
+	if v := ActiveTab(httptest.NewRequest("GET", "/docs", nil), "tab", tabs...); v != "docs" {
+		t.Error("For", "TestTabs", "expected tab matched by path, got", v)
+	}
+	if v := ActiveTab(httptest.NewRequest("GET", "/?tab=nope", nil), "tab", tabs...); v != "stats" {
+		t.Error("For", "TestTabs", "expected the first tab by default, got", v)
+	}
+}
@@ -0,0 +1,65 @@
+package embgui
+
+import (
+	"strings"
+)
+
+// TagItem is a single tag of a tags group
+// Color is one of bulma's colors, Size is "", "is-medium" or "is-large"
+// see Tags()
+type TagItem struct {
+	Text  string
+	Color string
+	Size  string
+}
+
+// classes joins non-empty CSS classes
+func classes(names ...string) string {
+	var nonEmpty []string
+	for _, name := range names {
+		if name != "" {
+			nonEmpty = append(nonEmpty, name)
+		}
+	}
+	return strings.Join(nonEmpty, " ")
+}
+
+// Tag generates bulma's tag, a small label for statuses and versions
+// color can be one of bulma's colors (see https://bulma.io/documentation/elements/tag/#colors)
+//
+//		row.Td("").Tag("running", "is-success")
+func (n *EmbNode) Tag(text string, color string) *EmbNode {
+	return n.add(&EmbNode{HTMLTag: "span", Class: classes("tag", color), Text: text})
+}
+
+// Tags generates a group of tags
+// it returns the group, so more tags can be added with Tag(), KeyValueTag() or DeletableTag()
+//
+//		page.Tags(embgui.TagItem{Text: "v1.4.2", Color: "is-info"}, embgui.TagItem{Text: "failed", Color: "is-danger", Size: "is-medium"})
+func (n *EmbNode) Tags(items ...TagItem) *EmbNode {
+	group := n.add(&EmbNode{HTMLTag: "div", Class: "tags"})
+	for _, item := range items {
+		group.add(&EmbNode{HTMLTag: "span", Class: classes("tag", item.Color, item.Size), Text: item.Text})
+	}
+	return group
+}
+
+// KeyValueTag generates two attached tags, like "env | prod"
+// the key is dark, color is used for the value
+func (n *EmbNode) KeyValueTag(key string, value string, color string) *EmbNode {
+	group := n.add(&EmbNode{HTMLTag: "div", Class: "tags has-addons", Style: "display: inline-flex; margin-right: .5rem"})
+	group.add(&EmbNode{HTMLTag: "span", Class: "tag is-dark", Text: key})
+	group.add(&EmbNode{HTMLTag: "span", Class: classes("tag", color), Text: value})
+	return group
+}
+
+// DeletableTag generates a tag with a delete button wrapped into a hidden form, just like DelButton()
+// your framework should support hidden _method tag
+func (n *EmbNode) DeletableTag(text string, color string, action string) *EmbNode {
+	form := &EmbNode{HTMLTag: "form", Action: action, Method: "POST", Class: "tags has-addons",
+		Style: "display: inline-flex; margin-right: .5rem"}
+	form.add(&EmbNode{HTMLTag: "input", Type: "hidden", Name: "_method", Value: "DELETE"})
+	form.add(&EmbNode{HTMLTag: "span", Class: classes("tag", color), Text: text})
+	form.add(&EmbNode{HTMLTag: "button", Type: "submit", Class: "tag is-delete", Text: "×"})
+	return n.add(form)
+}
@@ -0,0 +1,31 @@
+package embgui
+
+import (
+	"testing"
+)
+
+func TestTags(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	page.Tag("running", "is-success")
+	group := page.Tags(TagItem{Text: "v1.4.2"}, TagItem{Text: "failed", Color: "is-danger", Size: "is-medium"})
+	group.KeyValueTag("env", "prod", "is-warning")
+	group.DeletableTag("team-a", "is-info", "/labels/team-a")
+	v := page.render()
+	expectedResult := `<><span class='tag is-success'>running</span><div class='tags'><span class='tag'>v1.4.2</span>` +
+		`<span class='tag is-danger is-medium'>failed</span>` +
+		`<div class='tags has-addons' style='display: inline-flex; margin-right: .5rem'><span class='tag is-dark'>env</span>` +
+		`<span class='tag is-warning'>prod</span></div>` +
+		`<form class='tags has-addons' action='/labels/team-a' method='POST' style='display: inline-flex; margin-right: .5rem'>` +
+		`<input type='hidden' name='_method' value='DELETE'></input><span class='tag is-info'>team-a</span>` +
+		`<button class='tag is-delete' type='submit'>×</button></form></div></>`
+	if v != expectedResult {
+		t.Error(
+			"For", "TestTags",
+			"expected", expectedResult,
+			"got", v,
+		)
+	}
+}
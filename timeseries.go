@@ -0,0 +1,263 @@
+package embgui
+
+import (
+	"math"
+	"strings"
+	"time"
+)
+
+// TimeSeries is a named list of timestamped values
+// Times and Values are matched by index, NaN and infinite values are treated as missing data
+// see TimeSeriesChart()
+type TimeSeries struct {
+	Name   string
+	Times  []time.Time
+	Values []float64
+}
+
+// Threshold is a horizontal line drawn across a time-series chart
+type Threshold struct {
+	Label string
+	Value float64
+	Color string
+}
+
+// AlertBand is a shaded horizontal band between From and To values
+type AlertBand struct {
+	From  float64
+	To    float64
+	Color string
+}
+
+// TimeOptions customizes TimeSeriesChart
+// Location is used to format time labels, UTC is used if it's nil
+// Gap is a maximum distance between two points that are connected with a line,
+// if it's 0 only missing (NaN or infinite) values break the line
+// Color in Threshold and AlertBand can be a bulma's color like "is-danger" or a hex value
+type TimeOptions struct {
+	Location   *time.Location
+	Gap        time.Duration
+	Thresholds []Threshold
+	Bands      []AlertBand
+}
+
+// timeStep is a possible distance between time axis ticks
+type timeStep struct {
+	step   time.Duration
+	months int
+	format string
+}
+
+// timeSteps are ordered from the shortest to the longest
+var timeSteps = []timeStep{
+	{time.Second, 0, "15:04:05"},
+	{5 * time.Second, 0, "15:04:05"},
+	{15 * time.Second, 0, "15:04:05"},
+	{30 * time.Second, 0, "15:04:05"},
+	{time.Minute, 0, "15:04"},
+	{5 * time.Minute, 0, "15:04"},
+	{15 * time.Minute, 0, "15:04"},
+	{30 * time.Minute, 0, "15:04"},
+	{time.Hour, 0, "15:04"},
+	{3 * time.Hour, 0, "Jan 2 15:04"},
+	{6 * time.Hour, 0, "Jan 2 15:04"},
+	{12 * time.Hour, 0, "Jan 2 15:04"},
+	{24 * time.Hour, 0, "Jan 2"},
+	{2 * 24 * time.Hour, 0, "Jan 2"},
+	{7 * 24 * time.Hour, 0, "Jan 2"},
+	{0, 1, "Jan 2006"},
+	{0, 3, "Jan 2006"},
+	{0, 6, "Jan 2006"},
+	{0, 12, "2006"},
+}
+
+// timeTicks returns tick times between from and to and a layout to format them
+// ticks are aligned to round times in a given location, count is an approximate number of ticks
+func timeTicks(from time.Time, to time.Time, count int, loc *time.Location) ([]time.Time, string) {
+	span := to.Sub(from)
+	chosen := timeSteps[len(timeSteps)-1]
+	for _, ts := range timeSteps {
+		length := ts.step
+		if ts.months > 0 {
+			length = time.Duration(ts.months) * 30 * 24 * time.Hour
+		}
+		if span/length <= time.Duration(count) {
+			chosen = ts
+			break
+		}
+	}
+	from = from.In(loc)
+	var t time.Time
+	if chosen.months > 0 {
+		month := (int(from.Month())-1)/chosen.months*chosen.months + 1
+		t = time.Date(from.Year(), time.Month(month), 1, 0, 0, 0, 0, loc)
+	} else if chosen.step >= 24*time.Hour {
+		t = time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, loc)
+	} else {
+		// truncate in local time, so ticks land on round local hours
+		_, offset := from.Zone()
+		shift := time.Duration(offset) * time.Second
+		t = from.Add(shift).Truncate(chosen.step).Add(-shift)
+	}
+	var ticks []time.Time
+	for ; !t.After(to); t = nextTick(t, chosen) {
+		if !t.Before(from) {
+			ticks = append(ticks, t)
+		}
+	}
+	return ticks, chosen.format
+}
+
+// nextTick moves a tick by a single step
+func nextTick(t time.Time, ts timeStep) time.Time {
+	if ts.months > 0 {
+		return t.AddDate(0, ts.months, 0)
+	}
+	if ts.step >= 24*time.Hour {
+		return t.AddDate(0, 0, int(ts.step/(24*time.Hour)))
+	}
+	return t.Add(ts.step)
+}
+
+// TimeSeriesChart generates server-side rendered SVG chart with a real time axis
+// tick interval (from seconds to years) is chosen based on the time range
+// lines break where data is missing, thresholds and alert bands are drawn behind the data
+// a data table is attached for text-based browsers
+//
+//		page.TimeSeriesChart("latency", embgui.TimeOptions{Location: time.Local,
+//		Thresholds: []embgui.Threshold{{Label: "SLO", Value: 250, Color: "is-danger"}}},
+//		embgui.TimeSeries{Name: "p99", Times: times, Values: values})
+func (n *EmbNode) TimeSeriesChart(title string, opts TimeOptions, series ...TimeSeries) *EmbNode {
+	loc := opts.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	var from, to time.Time
+	first := true
+	yMin, yMax := math.Inf(1), math.Inf(-1)
+	legend := false
+	for _, sr := range series {
+		if sr.Name != "" {
+			legend = true
+		}
+		for i, t := range sr.Times {
+			if first || t.Before(from) {
+				from = t
+			}
+			if first || t.After(to) {
+				to = t
+			}
+			first = false
+			if i < len(sr.Values) && finite(sr.Values[i]) {
+				yMin, yMax = minFloat(yMin, sr.Values[i]), maxFloat(yMax, sr.Values[i])
+			}
+		}
+	}
+	for _, th := range opts.Thresholds {
+		if finite(th.Value) {
+			yMin, yMax = minFloat(yMin, th.Value), maxFloat(yMax, th.Value)
+		}
+	}
+	if math.IsInf(yMin, 0) {
+		yMin, yMax = 0, 1
+	}
+	if first {
+		// without data there's no time range, so the time axis is left without ticks
+		from, to = time.Unix(0, 0), time.Unix(0, 0).Add(time.Hour)
+	}
+	if !to.After(from) {
+		from, to = from.Add(-time.Minute), to.Add(time.Minute)
+	}
+	yTicks := niceTicks(yMin, yMax, 5)
+	yLabels := tickLabels(yTicks)
+	p := newXYPlot(title, legend, yLabels)
+	p.xMin, p.xMax = 0, to.Sub(from).Seconds()
+	p.yMin, p.yMax = yTicks[0], yTicks[len(yTicks)-1]
+	// tx returns horizontal position of a time
+	tx := func(t time.Time) float64 {
+		return p.x(t.Sub(from).Seconds())
+	}
+
+	s := newSVG(chartWidth, chartHeight, title)
+	drawTitle(s, title)
+	for _, band := range opts.Bands {
+		lo, hi := maxFloat(minFloat(band.From, band.To), p.yMin), minFloat(maxFloat(band.From, band.To), p.yMax)
+		if hi > lo {
+			s.tag("rect", "", "x", fmtFloat(p.left), "y", fmtFloat(p.y(hi)), "width", fmtFloat(p.right-p.left),
+				"height", fmtFloat(p.y(lo)-p.y(hi)), "fill", colorValue(band.Color, bulmaColors["is-warning"]), "fill-opacity", "0.2")
+		}
+	}
+	p.drawYAxis(s, yTicks, yLabels)
+	ticks, layout := timeTicks(from, to, 6, loc)
+	if first {
+		ticks = nil
+	}
+	tickPos := make([]float64, len(ticks))
+	labels := make([]string, len(ticks))
+	for i, t := range ticks {
+		tickPos[i] = t.Sub(from).Seconds()
+		labels[i] = t.In(loc).Format(layout)
+	}
+	p.drawXAxis(s, tickPos, labels, true)
+	for _, th := range opts.Thresholds {
+		if !finite(th.Value) {
+			continue
+		}
+		color := colorValue(th.Color, bulmaColors["is-danger"])
+		y := p.y(th.Value)
+		s.tag("line", "", "x1", fmtFloat(p.left), "y1", fmtFloat(y), "x2", fmtFloat(p.right), "y2", fmtFloat(y),
+			"stroke", color, "stroke-dasharray", "6,4")
+		if th.Label != "" {
+			s.text(p.right-4, y-4, "end", color, th.Label)
+		}
+	}
+
+	var names, colors []string
+	for i, sr := range series {
+		color := seriesColor(i)
+		names, colors = append(names, sr.Name), append(colors, color)
+		var segment []string
+		var last time.Time
+		// flush draws current segment of a line, a single point is drawn as a dot
+		flush := func() {
+			if len(segment) > 1 {
+				s.tag("polyline", "", "points", strings.Join(segment, " "), "fill", "none", "stroke", color, "stroke-width", "2")
+			} else if len(segment) == 1 {
+				xy := strings.Split(segment[0], ",")
+				s.tag("circle", "", "cx", xy[0], "cy", xy[1], "r", "2", "fill", color)
+			}
+			segment = nil
+		}
+		for j, t := range sr.Times {
+			if j >= len(sr.Values) || !finite(sr.Values[j]) {
+				flush()
+				continue
+			}
+			if len(segment) > 0 && opts.Gap > 0 && t.Sub(last) > opts.Gap {
+				flush()
+			}
+			segment = append(segment, fmtFloat(tx(t))+","+fmtFloat(p.y(sr.Values[j])))
+			last = t
+		}
+		flush()
+	}
+	if legend {
+		drawLegend(s, names, colors)
+	}
+
+	figure := n.chart(s.String())
+	table := figure.textFallback().GenTableBody([]string{"series", "time", "value"})
+	for _, sr := range series {
+		for j, t := range sr.Times {
+			value := ""
+			if j < len(sr.Values) && finite(sr.Values[j]) {
+				value = fmtValue(sr.Values[j])
+			}
+			row := table.Tr()
+			row.Td(sr.Name)
+			row.Td(t.In(loc).Format("2006-01-02 15:04:05 MST"))
+			row.Td(value)
+		}
+	}
+	return figure
+}
@@ -0,0 +1,116 @@
+package embgui
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTimeTicks(t *testing.T) {
+	warsaw := time.FixedZone("CET", 3600)
+	base := time.Date(2020, 3, 10, 12, 7, 13, 0, time.UTC)
+	tests := []struct {
+		from     time.Time
+		to       time.Time
+		loc      *time.Location
+		expected []string
+	}{
+		{base, base.Add(50 * time.Second), time.UTC, []string{"12:07:15", "12:07:30", "12:07:45", "12:08:00"}},
+		{base, base.Add(5 * time.Hour), time.UTC, []string{"13:00", "14:00", "15:00", "16:00", "17:00"}},
+		{base, base.Add(5 * time.Hour), warsaw, []string{"14:00", "15:00", "16:00", "17:00", "18:00"}},
+		{base, base.Add(4 * 24 * time.Hour), time.UTC, []string{"Mar 11", "Mar 12", "Mar 13", "Mar 14"}},
+		{base, base.AddDate(0, 5, 0), time.UTC, []string{"Apr 2020", "May 2020", "Jun 2020", "Jul 2020", "Aug 2020"}},
+	}
+	for _, test := range tests {
+		ticks, layout := timeTicks(test.from, test.to, 6, test.loc)
+		var v []string
+		for _, tick := range ticks {
+			v = append(v, tick.In(test.loc).Format(layout))
+		}
+		if !reflect.DeepEqual(v, test.expected) {
+			t.Error(
+				"For", "TestTimeTicks", test.to.Sub(test.from),
+				"expected", test.expected,
+				"got", v,
+			)
+		}
+	}
+}
+
+func TestTimeSeriesChart(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	base := time.Date(2020, 3, 10, 12, 0, 0, 0, time.UTC)
+	times := []time.Time{base, base.Add(time.Minute), base.Add(2 * time.Minute), base.Add(3 * time.Minute),
+		base.Add(4 * time.Minute), base.Add(10 * time.Minute)}
+	values := []float64{100, 120, math.NaN(), 150, 180, 170}
+	v := page.TimeSeriesChart("latency", TimeOptions{Gap: 2 * time.Minute,
+		Thresholds: []Threshold{{Label: "SLO", Value: 250, Color: "is-danger"}},
+		Bands:      []AlertBand{{From: 200, To: 300}}},
+		TimeSeries{Name: "p99", Times: times, Values: values}).render()
+	if strings.Count(v, "<polyline") != 2 || strings.Count(v, "<circle") != 1 {
+		t.Error("For", "TestTimeSeriesChart", "expected line to break at missing data and gaps, got", v)
+	}
+	testStrings := []string{`stroke='#ff3860' stroke-dasharray='6,4'/>`,
+		`>SLO</text>`,
+		`fill='#ffdd57' fill-opacity='0.2'/>`,
+		`>12:05</text>`,
+		`<tr><td>p99</td><td>2020-03-10 12:02:00 UTC</td><td></td></tr>`}
+	for _, str := range testStrings {
+		if strings.Contains(v, str) == false {
+			t.Error(
+				"For", "TestTimeSeriesChart",
+				"expected to have", str,
+				"got", v,
+			)
+		}
+	}
+}
+
+func TestTimeSeriesChartEmpty(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	v := page.TimeSeriesChart("empty", TimeOptions{}, TimeSeries{Name: "p99"}).render()
+	if v != page.TimeSeriesChart("empty", TimeOptions{}, TimeSeries{Name: "p99"}).render() {
+		t.Error("For", "TestTimeSeriesChartEmpty", "expected deterministic output")
+	}
+	if strings.Contains(v, ":00</text>") || strings.Contains(v, "<polyline") {
+		t.Error("For", "TestTimeSeriesChartEmpty", "expected time axis without ticks, got", v)
+	}
+	// zero time is a valid data point, not a marker of missing data
+	zero := time.Time{}
+	v = page.TimeSeriesChart("zero", TimeOptions{},
+		TimeSeries{Times: []time.Time{zero, zero.Add(time.Minute)}, Values: []float64{1, 2}}).render()
+	if !strings.Contains(v, ">00:01:00</text>") || !strings.Contains(v, "<polyline") {
+		t.Error("For", "TestTimeSeriesChartEmpty", "expected chart starting at zero time, got", v)
+	}
+}
+
+func TestTimeSeriesChartNonFinite(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	base := time.Date(2020, 3, 10, 12, 0, 0, 0, time.UTC)
+	times := []time.Time{base, base.Add(time.Minute), base.Add(2 * time.Minute), base.Add(3 * time.Minute),
+		base.Add(4 * time.Minute)}
+	values := []float64{1, 2, math.Inf(1), 3, math.Inf(-1)}
+	v := page.TimeSeriesChart("inf", TimeOptions{Thresholds: []Threshold{{Label: "max", Value: math.Inf(1)}}},
+		TimeSeries{Name: "p99", Times: times, Values: values}).render()
+	svg := v[:strings.Index(v, "<div class='is-hidden'>")]
+	if strings.Contains(svg, "NaN") || strings.Contains(svg, "Inf") || strings.Contains(svg, ">max</text>") {
+		t.Error("For", "TestTimeSeriesChartNonFinite", "expected non-finite values to be skipped, got", svg)
+	}
+	if strings.Count(svg, "<polyline") != 1 || strings.Count(svg, "<circle") != 1 {
+		t.Error("For", "TestTimeSeriesChartNonFinite", "expected line to break at infinite values, got", svg)
+	}
+	if !strings.Contains(v, "<tr><td>p99</td><td>2020-03-10 12:02:00 UTC</td><td></td></tr>") {
+		t.Error("For", "TestTimeSeriesChartNonFinite", "expected empty table cell for infinite value, got", v)
+	}
+}
@@ -0,0 +1,179 @@
+package embgui
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// TreeOptions customizes TreeView and JSONView
+// MaxDepth cuts the tree below a given level, deeper objects and arrays are shown only as their sizes (0 means no limit)
+// Expand is a number of levels unfolded at start, only the top level is unfolded if it's 0
+// RawModal is an id of a modal (see Modal()) with indented raw JSON, it's not generated if RawModal is empty
+type TreeOptions struct {
+	MaxDepth int
+	Expand   int
+	RawModal string
+}
+
+// jsonMember is a single key of a JSON object
+type jsonMember struct {
+	key   string
+	value interface{}
+}
+
+// jsonObject is a JSON object that keeps order of its keys
+type jsonObject []jsonMember
+
+// decodeJSON decodes a single JSON value, objects are decoded into jsonObject, numbers into json.Number
+func decodeJSON(dec *json.Decoder) (interface{}, error) {
+	token, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	delim, ok := token.(json.Delim)
+	if !ok {
+		return token, nil
+	}
+	switch delim {
+	case '{':
+		object := jsonObject{}
+		for dec.More() {
+			key, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			value, err := decodeJSON(dec)
+			if err != nil {
+				return nil, err
+			}
+			object = append(object, jsonMember{key.(string), value})
+		}
+		_, err = dec.Token()
+		return object, err
+	case '[':
+		array := []interface{}{}
+		for dec.More() {
+			value, err := decodeJSON(dec)
+			if err != nil {
+				return nil, err
+			}
+			array = append(array, value)
+		}
+		_, err = dec.Token()
+		return array, err
+	}
+	return nil, errors.New("unexpected " + delim.String() + " in JSON")
+}
+
+// plural formats a count with a singular or plural noun
+func plural(count int, noun string) string {
+	if count == 1 {
+		return "1 " + noun
+	}
+	return strconv.Itoa(count) + " " + noun + "s"
+}
+
+// quoteJSON quotes a string the way it's written in JSON, but without escaping HTML characters
+func quoteJSON(s string) string {
+	var buffer bytes.Buffer
+	enc := json.NewEncoder(&buffer)
+	enc.SetEscapeHTML(false)
+	enc.Encode(s)
+	return strings.TrimSuffix(buffer.String(), "\n")
+}
+
+// TreeView generates a collapsible tree of any value that can be marshaled to JSON
+// see JSONView()
+//
+//		page.TreeView(payload, embgui.TreeOptions{MaxDepth: 5, RawModal: "payload-json"})
+func (n *EmbNode) TreeView(data interface{}, opts TreeOptions) (*EmbNode, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	return n.JSONView(string(raw), opts)
+}
+
+// JSONView generates a collapsible tree of raw JSON that folds without JavaScript (HTML details/summary)
+// values are colored by type, objects show their key counts and arrays their lengths, order of keys is kept
+// with RawModal option a button opens indented raw JSON in a modal, for a tab use Tabs() with Pre()
+// it returns an error for invalid JSON
+//
+//		page.JSONView(body, embgui.TreeOptions{Expand: 2})
+func (n *EmbNode) JSONView(raw string, opts TreeOptions) (*EmbNode, error) {
+	dec := json.NewDecoder(strings.NewReader(raw))
+	dec.UseNumber()
+	value, err := decodeJSON(dec)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, errors.New("unexpected data after JSON value")
+	}
+	tree := n.add(&EmbNode{HTMLTag: "div", Class: "embgui-tree"})
+	if opts.RawModal != "" {
+		var indented bytes.Buffer
+		if err := json.Indent(&indented, []byte(raw), "", "  "); err != nil {
+			return nil, err
+		}
+		tree.add(&EmbNode{Text: "Raw JSON", HTMLTag: "a", Href: "#" + opts.RawModal, Class: "button is-small is-pulled-right"})
+		n.Modal(opts.RawModal, "Raw JSON").Pre(indented.String(), "")
+	}
+	tree.treeNode("", value, 0, opts)
+	return tree, nil
+}
+
+// treeNode adds a single value of a tree, the root value (depth 0) has no key, objects and arrays are added as details with nested lists
+func (n *EmbNode) treeNode(key string, value interface{}, depth int, opts TreeOptions) {
+	var label *EmbNode
+	var size string
+	count := 0
+	switch v := value.(type) {
+	case jsonObject:
+		size, count = "{"+plural(len(v), "key")+"}", len(v)
+	case []interface{}:
+		size, count = "["+plural(len(v), "item")+"]", len(v)
+	}
+	if size == "" || count == 0 || (opts.MaxDepth > 0 && depth >= opts.MaxDepth) {
+		label = n.add(&EmbNode{HTMLTag: "div"})
+	} else {
+		details := n.add(&EmbNode{HTMLTag: "details", Open: depth == 0 || depth < opts.Expand})
+		label = details.add(&EmbNode{HTMLTag: "summary"})
+		list := details.add(&EmbNode{HTMLTag: "ul"})
+		switch v := value.(type) {
+		case jsonObject:
+			for _, m := range v {
+				list.add(&EmbNode{HTMLTag: "li"}).treeNode(m.key, m.value, depth+1, opts)
+			}
+		case []interface{}:
+			for i, item := range v {
+				list.add(&EmbNode{HTMLTag: "li"}).treeNode(strconv.Itoa(i), item, depth+1, opts)
+			}
+		}
+	}
+	if depth > 0 {
+		label.add(&EmbNode{HTMLTag: "span", Class: "has-text-weight-semibold", Text: key})
+		label.add(&EmbNode{HTMLTag: "span", Text: ": "})
+	}
+	if size != "" {
+		if count > 0 && opts.MaxDepth > 0 && depth >= opts.MaxDepth {
+			size += " …"
+		}
+		label.add(&EmbNode{HTMLTag: "span", Class: "has-text-grey", Text: size})
+		return
+	}
+	switch v := value.(type) {
+	case string:
+		label.add(&EmbNode{HTMLTag: "span", Class: "has-text-success", Text: quoteJSON(v)})
+	case json.Number:
+		label.add(&EmbNode{HTMLTag: "span", Class: "has-text-info", Text: v.String()})
+	case bool:
+		label.add(&EmbNode{HTMLTag: "span", Class: "has-text-danger", Text: strconv.FormatBool(v)})
+	default:
+		label.add(&EmbNode{HTMLTag: "span", Class: "has-text-grey", Text: "null"})
+	}
+}
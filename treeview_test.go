@@ -0,0 +1,74 @@
+package embgui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestJSONView(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	_, err := page.JSONView(`{"name": "a<b", "size": 1.50, "tags": ["x"], "ok": true, "meta": {"owner": {"id": 1}}, "none": null, "empty": []}`,
+		TreeOptions{MaxDepth: 2})
+	if err != nil {
+		t.Error("For", "TestJSONView", "Error:", err.Error())
+	}
+	v := page.render()
+	expectedResult := `<><div class='embgui-tree'><details open><summary><span class='has-text-grey'>{7 keys}</span></summary><ul>` +
+		`<li><div><span class='has-text-weight-semibold'>name</span><span>: </span><span class='has-text-success'>&#34;a&lt;b&#34;</span></div></li>` +
+		`<li><div><span class='has-text-weight-semibold'>size</span><span>: </span><span class='has-text-info'>1.50</span></div></li>` +
+		`<li><details><summary><span class='has-text-weight-semibold'>tags</span><span>: </span><span class='has-text-grey'>[1 item]</span></summary>` +
+		`<ul><li><div><span class='has-text-weight-semibold'>0</span><span>: </span><span class='has-text-success'>&#34;x&#34;</span></div></li></ul></details></li>` +
+		`<li><div><span class='has-text-weight-semibold'>ok</span><span>: </span><span class='has-text-danger'>true</span></div></li>` +
+		`<li><details><summary><span class='has-text-weight-semibold'>meta</span><span>: </span><span class='has-text-grey'>{1 key}</span></summary>` +
+		`<ul><li><div><span class='has-text-weight-semibold'>owner</span><span>: </span><span class='has-text-grey'>{1 key} …</span></div></li></ul></details></li>` +
+		`<li><div><span class='has-text-weight-semibold'>none</span><span>: </span><span class='has-text-grey'>null</span></div></li>` +
+		`<li><div><span class='has-text-weight-semibold'>empty</span><span>: </span><span class='has-text-grey'>[0 items]</span></div></li>` +
+		`</ul></details></div></>`
+	if v != expectedResult {
+		t.Error(
+			"For", "TestJSONView",
+			"expected", expectedResult,
+			"got", v,
+		)
+	}
+	for _, invalid := range []string{`{"a": }`, `[1, 2`, `{} {}`, ``} {
+		if _, err := page.JSONView(invalid, TreeOptions{}); err == nil {
+			t.Error("For", invalid, "expected error")
+		}
+	}
+}
+
+func TestTreeView(t *testing.T) {
+	page := preparePage()
+	if page == nil {
+		t.Errorf("can't initialize test page")
+	}
+	_, err := page.TreeView(struct {
+		ID    int      `json:"id"`
+		Roles []string `json:"roles"`
+	}{7, []string{"admin"}}, TreeOptions{Expand: 2, RawModal: "raw-user"})
+	if err != nil {
+		t.Error("For", "TestTreeView", "Error:", err.Error())
+	}
+	v := page.render()
+	for _, expected := range []string{
+		`<a class='button is-small is-pulled-right' href='#raw-user'>Raw JSON</a>`,
+		`<details open><summary><span class='has-text-weight-semibold'>roles</span>`,
+		`<div class='modal' id='raw-user'>`,
+		"<pre>{\n  &#34;id&#34;: 7,\n  &#34;roles&#34;: [\n    &#34;admin&#34;\n  ]\n}</pre>",
+	} {
+		if !strings.Contains(v, expected) {
+			t.Error(
+				"For", "TestTreeView",
+				"expected", expected,
+				"got", v,
+			)
+		}
+	}
+	if _, err := page.TreeView(func() {}, TreeOptions{}); err == nil {
+		t.Error("For", "TestTreeView", "expected error for a function")
+	}
+}